@@ -4,18 +4,20 @@ import { BoardProvider } from "@/context/BoardContext";
 import { AppLayout } from "@/components/layout/AppLayout";
 import { Sidebar } from "@/components/layout/Sidebar";
 import { BoardView } from "@/components/board/BoardView";
+import { ErrorBanner } from "@/components/common/ErrorBanner";
 import { useBoard } from "@/hooks/useBoard";
 
 function AppContent() {
-  const { loadBoards } = useBoard();
+  const { loadBoards, reportError } = useBoard();
 
   useEffect(() => {
-    loadBoards();
-  }, [loadBoards]);
+    loadBoards().catch(reportError);
+  }, [loadBoards, reportError]);
 
   return (
     <AppLayout sidebar={<Sidebar />}>
       <BoardView />
+      <ErrorBanner />
     </AppLayout>
   );
 }
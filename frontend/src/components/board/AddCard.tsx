@@ -10,17 +10,21 @@ interface AddCardProps {
 }
 
 export function AddCard({ columnId }: AddCardProps) {
-  const { activeBoardId, loadBoard } = useBoard();
+  const { activeBoardId, loadBoard, reportError } = useBoard();
   const [isAdding, setIsAdding] = useState(false);
   const [title, setTitle] = useState("");
 
   const handleAdd = async () => {
     const trimmed = title.trim();
     if (!trimmed) return;
-    await CreateCard(columnId, trimmed);
-    setTitle("");
-    setIsAdding(false);
-    if (activeBoardId) await loadBoard(activeBoardId);
+    try {
+      await CreateCard(columnId, trimmed);
+      setTitle("");
+      setIsAdding(false);
+      if (activeBoardId) await loadBoard(activeBoardId);
+    } catch (err) {
+      reportError(err);
+    }
   };
 
   if (!isAdding) {
@@ -6,17 +6,21 @@ import { CreateColumn } from "../../../wailsjs/go/adapter/Handler";
 import { useBoard } from "@/hooks/useBoard";
 
 export function AddColumn() {
-  const { activeBoardId, loadBoard } = useBoard();
+  const { activeBoardId, loadBoard, reportError } = useBoard();
   const [isAdding, setIsAdding] = useState(false);
   const [title, setTitle] = useState("");
 
   const handleAdd = async () => {
     const trimmed = title.trim();
     if (!trimmed || !activeBoardId) return;
-    await CreateColumn(activeBoardId, trimmed);
-    setTitle("");
-    setIsAdding(false);
-    await loadBoard(activeBoardId);
+    try {
+      await CreateColumn(activeBoardId, trimmed);
+      setTitle("");
+      setIsAdding(false);
+      await loadBoard(activeBoardId);
+    } catch (err) {
+      reportError(err);
+    }
   };
 
   if (!isAdding) {
@@ -2,6 +2,7 @@ import { useSortable } from "@dnd-kit/sortable";
 import { CSS } from "@dnd-kit/utilities";
 import { domain } from "../../../wailsjs/go/models";
 import { GripVertical, CalendarClock } from "lucide-react";
+import { toLocalDate } from "@/lib/utils";
 
 interface CardProps {
   card: domain.Card;
@@ -15,9 +16,9 @@ const PRIORITY_COLORS: Record<string, string> = {
   high: "bg-rose-400",
 };
 
-function getDueDateInfo(dueDateStr?: string): { label: string; isNearDue: boolean; isOverdue: boolean } | null {
-  if (!dueDateStr) return null;
-  const due = new Date(dueDateStr);
+function getDueDateInfo(dueAt: string | undefined, allDay: boolean): { label: string; isNearDue: boolean; isOverdue: boolean } | null {
+  const due = toLocalDate(dueAt, allDay);
+  if (!due) return null;
   const now = new Date();
   now.setHours(0, 0, 0, 0);
   const diffDays = Math.ceil((due.getTime() - now.getTime()) / (1000 * 60 * 60 * 24));
@@ -45,7 +46,7 @@ export function Card({ card, onClick, isDragOverlay }: CardProps) {
   };
 
   const priorityColor = PRIORITY_COLORS[card.priority] ?? PRIORITY_COLORS.medium;
-  const dueDateInfo = getDueDateInfo(card.due_date);
+  const dueDateInfo = getDueDateInfo(card.due_at, card.all_day);
 
   return (
     <div
@@ -19,6 +19,7 @@ import { Input } from "@/components/ui/input";
 import { UpdateCard, DeleteCard } from "../../../wailsjs/go/adapter/Handler";
 import { domain } from "../../../wailsjs/go/models";
 import { useBoard } from "@/hooks/useBoard";
+import { formatDateOnly, toLocalDate } from "@/lib/utils";
 import { ConfirmDialog } from "@/components/common/ConfirmDialog";
 import { CalendarIcon, Trash2, X } from "lucide-react";
 
@@ -35,7 +36,7 @@ const PRIORITY_OPTIONS = [
 ];
 
 export function CardDetail({ card, open, onOpenChange }: CardDetailProps) {
-  const { activeBoardId, loadBoard } = useBoard();
+  const { activeBoardId, loadBoard, reportError } = useBoard();
   const [title, setTitle] = useState("");
   const [description, setDescription] = useState("");
   const [showDelete, setShowDelete] = useState(false);
@@ -51,10 +52,14 @@ export function CardDetail({ card, open, onOpenChange }: CardDetailProps) {
     async (updates: Record<string, string | undefined>) => {
       if (!card) return;
       const cardUpdate = new domain.CardUpdate(updates);
-      await UpdateCard(card.id, cardUpdate);
-      if (activeBoardId) await loadBoard(activeBoardId);
+      try {
+        await UpdateCard(card.id, cardUpdate);
+        if (activeBoardId) await loadBoard(activeBoardId);
+      } catch (err) {
+        reportError(err);
+      }
     },
-    [card, activeBoardId, loadBoard]
+    [card, activeBoardId, loadBoard, reportError]
   );
 
   const handleTitleBlur = async () => {
@@ -74,25 +79,28 @@ export function CardDetail({ card, open, onOpenChange }: CardDetailProps) {
 
   const handleDateSelect = async (date: Date | undefined) => {
     if (!date) return;
-    const dateStr = date.toISOString().split("T")[0];
-    await saveField({ due_date: dateStr });
+    await saveField({ due_at: formatDateOnly(date) });
   };
 
   const handleClearDate = async () => {
-    await saveField({ due_date: "" });
+    await saveField({ due_at: "" });
   };
 
   const handleDelete = async () => {
     if (!card) return;
-    await DeleteCard(card.id);
     setShowDelete(false);
-    onOpenChange(false);
-    if (activeBoardId) await loadBoard(activeBoardId);
+    try {
+      await DeleteCard(card.id);
+      onOpenChange(false);
+      if (activeBoardId) await loadBoard(activeBoardId);
+    } catch (err) {
+      reportError(err);
+    }
   };
 
   if (!card) return null;
 
-  const dueDate = card.due_date ? new Date(card.due_date) : undefined;
+  const dueDate = toLocalDate(card.due_at, card.all_day);
 
   return (
     <>
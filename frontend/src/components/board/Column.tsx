@@ -18,7 +18,7 @@ interface ColumnProps {
 }
 
 export function Column({ id, title, cardIds, cardCount, isLast, children }: ColumnProps) {
-  const { activeBoardId, loadBoard } = useBoard();
+  const { activeBoardId, loadBoard, reportError } = useBoard();
   const [isEditing, setIsEditing] = useState(false);
   const [editTitle, setEditTitle] = useState(title);
   const [showMenu, setShowMenu] = useState(false);
@@ -33,15 +33,23 @@ export function Column({ id, title, cardIds, cardCount, isLast, children }: Colu
       setEditTitle(title);
       return;
     }
-    await UpdateColumn(id, trimmed);
-    setIsEditing(false);
-    if (activeBoardId) await loadBoard(activeBoardId);
+    try {
+      await UpdateColumn(id, trimmed);
+      setIsEditing(false);
+      if (activeBoardId) await loadBoard(activeBoardId);
+    } catch (err) {
+      reportError(err);
+    }
   };
 
   const handleDelete = async () => {
-    await DeleteColumn(id, "");
     setShowDelete(false);
-    if (activeBoardId) await loadBoard(activeBoardId);
+    try {
+      await DeleteColumn(id, "");
+      if (activeBoardId) await loadBoard(activeBoardId);
+    } catch (err) {
+      reportError(err);
+    }
   };
 
   return (
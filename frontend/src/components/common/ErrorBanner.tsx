@@ -0,0 +1,29 @@
+import { useEffect } from "react";
+import { X } from "lucide-react";
+import { useBoardContext } from "@/context/BoardContext";
+
+// ErrorBanner shows the last error a Handler call reported, until dismissed or
+// replaced; it hides itself after a few seconds.
+export function ErrorBanner() {
+  const { error, clearError } = useBoardContext();
+
+  useEffect(() => {
+    if (!error) return;
+    const timer = setTimeout(clearError, 6000);
+    return () => clearTimeout(timer);
+  }, [error, clearError]);
+
+  if (!error) return null;
+
+  return (
+    <div
+      role="alert"
+      className="fixed bottom-4 right-4 z-50 flex max-w-sm items-start gap-2 rounded-lg border border-destructive/30 bg-background px-4 py-3 text-sm text-destructive shadow-lg"
+    >
+      <span className="flex-1">{error}</span>
+      <button onClick={clearError} className="shrink-0 rounded p-0.5 hover:bg-accent">
+        <X className="h-3.5 w-3.5" />
+      </button>
+    </div>
+  );
+}
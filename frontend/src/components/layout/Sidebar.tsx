@@ -6,7 +6,7 @@ import { ConfirmDialog } from "@/components/common/ConfirmDialog";
 import { Plus, Pencil, Trash2, LayoutDashboard, Check, X } from "lucide-react";
 
 export function Sidebar() {
-  const { boards, activeBoardId, loadBoard, createBoard, updateBoard, deleteBoard } = useBoard();
+  const { boards, activeBoardId, loadBoard, createBoard, updateBoard, deleteBoard, reportError } = useBoard();
 
   const [isAdding, setIsAdding] = useState(false);
   const [newTitle, setNewTitle] = useState("");
@@ -17,22 +17,35 @@ export function Sidebar() {
   const handleCreate = async () => {
     const trimmed = newTitle.trim();
     if (!trimmed) return;
-    await createBoard(trimmed);
-    setNewTitle("");
-    setIsAdding(false);
+    try {
+      await createBoard(trimmed);
+      setNewTitle("");
+      setIsAdding(false);
+    } catch (err) {
+      reportError(err);
+    }
   };
 
   const handleUpdate = async (id: string) => {
     const trimmed = editTitle.trim();
     if (!trimmed) return;
-    await updateBoard(id, trimmed);
-    setEditingId(null);
+    try {
+      await updateBoard(id, trimmed);
+      setEditingId(null);
+    } catch (err) {
+      reportError(err);
+    }
   };
 
   const handleDelete = async () => {
     if (!deleteTarget) return;
-    await deleteBoard(deleteTarget.id);
+    const { id } = deleteTarget;
     setDeleteTarget(null);
+    try {
+      await deleteBoard(id);
+    } catch (err) {
+      reportError(err);
+    }
   };
 
   return (
@@ -67,7 +80,7 @@ export function Sidebar() {
               </div>
             ) : (
               <button
-                onClick={() => loadBoard(board.id)}
+                onClick={() => loadBoard(board.id).catch(reportError)}
                 className={`w-full flex items-center gap-2.5 px-3 py-2 rounded-lg text-sm transition-all ${
                   activeBoardId === board.id
                     ? "bg-primary/10 text-primary font-medium shadow-sm"
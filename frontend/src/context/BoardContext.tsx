@@ -1,5 +1,6 @@
 import { createContext, useContext, useState, useCallback, type ReactNode } from "react";
 import { domain, application } from "../../wailsjs/go/models";
+import { describeAppError } from "@/lib/errors";
 
 interface BoardContextType {
   boards: domain.Board[];
@@ -10,6 +11,9 @@ interface BoardContextType {
   setActiveBoardId: (id: string | null) => void;
   refreshKey: number;
   triggerRefresh: () => void;
+  error: string | null;
+  reportError: (err: unknown) => void;
+  clearError: () => void;
 }
 
 const BoardContext = createContext<BoardContextType | null>(null);
@@ -19,11 +23,20 @@ export function BoardProvider({ children }: { children: ReactNode }) {
   const [activeBoard, setActiveBoard] = useState<application.BoardData | null>(null);
   const [activeBoardId, setActiveBoardId] = useState<string | null>(null);
   const [refreshKey, setRefreshKey] = useState(0);
+  const [error, setError] = useState<string | null>(null);
 
   const triggerRefresh = useCallback(() => {
     setRefreshKey((k) => k + 1);
   }, []);
 
+  const reportError = useCallback((err: unknown) => {
+    setError(describeAppError(err));
+  }, []);
+
+  const clearError = useCallback(() => {
+    setError(null);
+  }, []);
+
   return (
     <BoardContext.Provider
       value={{
@@ -35,6 +48,9 @@ export function BoardProvider({ children }: { children: ReactNode }) {
         setActiveBoardId,
         refreshKey,
         triggerRefresh,
+        error,
+        reportError,
+        clearError,
       }}
     >
       {children}
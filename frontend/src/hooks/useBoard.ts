@@ -17,6 +17,7 @@ export function useBoard() {
     activeBoardId,
     setActiveBoardId,
     triggerRefresh,
+    reportError,
   } = useBoardContext();
 
   const loadBoards = useCallback(async () => {
@@ -83,5 +84,6 @@ export function useBoard() {
     updateBoard,
     deleteBoard,
     triggerRefresh,
+    reportError,
   };
 }
@@ -11,7 +11,7 @@ import { domain, application } from "../../wailsjs/go/models";
 import { useBoard } from "@/hooks/useBoard";
 
 export function useDragAndDrop() {
-  const { activeBoard, setActiveBoard, activeBoardId, loadBoard } = useBoard();
+  const { activeBoard, setActiveBoard, activeBoardId, loadBoard, reportError } = useBoard();
   const [activeCardId, setActiveCardId] = useState<UniqueIdentifier | null>(null);
 
   // Track the original column when drag starts — survives onDragOver state changes
@@ -165,14 +165,14 @@ export function useDragAndDrop() {
       // Persist to backend
       try {
         await MoveCard(activeId, targetCol.column.id, newPosition);
-      } catch {
-        if (activeBoardId) await loadBoard(activeBoardId);
-        return;
+      } catch (err) {
+        // e.g. transition_not_allowed: explain why the card snapped back.
+        reportError(err);
       }
 
-      if (activeBoardId) await loadBoard(activeBoardId);
+      if (activeBoardId) await loadBoard(activeBoardId).catch(reportError);
     },
-    [activeBoard, findColumnByCardId, isColumnId, setActiveBoard, activeBoardId, loadBoard]
+    [activeBoard, findColumnByCardId, isColumnId, setActiveBoard, activeBoardId, loadBoard, reportError]
   );
 
   const activeCard = activeCardId
@@ -0,0 +1,66 @@
+export interface FieldError {
+  field: string;
+  code: string;
+  message: string;
+}
+
+// AppError mirrors adapter.ErrorEnvelope returned by every Handler method.
+export interface AppError {
+  code: string;
+  message: string;
+  details: {
+    error: string;
+    fields?: FieldError[];
+  };
+}
+
+// parseAppError decodes a rejected Handler promise into an AppError.
+// Anything that is not an envelope is reported as an "internal" error.
+export function parseAppError(err: unknown): AppError {
+  const raw = typeof err === "string" ? err : err instanceof Error ? err.message : String(err);
+  try {
+    const parsed = JSON.parse(raw);
+    if (parsed && typeof parsed.code === "string") {
+      return parsed as AppError;
+    }
+  } catch {
+    // not JSON — fall through
+  }
+  return { code: "internal", message: raw, details: { error: raw } };
+}
+
+// fieldLabels names the fields the UI edits, in zh-TW.
+const fieldLabels: Record<string, string> = {
+  title: "標題",
+  description: "描述",
+  priority: "優先級",
+  due_at: "到期日",
+  column_id: "欄位",
+  position: "位置",
+};
+
+// fieldCodeMessages renders a field error by its validation code (domain.Code* in Go).
+const fieldCodeMessages: Record<string, (label: string) => string> = {
+  required: (label) => `${label}為必填`,
+  too_long: (label) => `${label}超過長度上限`,
+  invalid_value: (label) => `${label}的值無效`,
+  invalid_format: (label) => `${label}的格式不正確`,
+  out_of_order: (label) => `${label}的順序不正確`,
+  wrong_board: (label) => `${label}不屬於此看板`,
+};
+
+// fieldErrorMessage localizes a field error by its code, falling back to the
+// backend's English message for codes the catalogue does not know.
+export function fieldErrorMessage(fe: FieldError): string {
+  const render = fieldCodeMessages[fe.code];
+  return render ? render(fieldLabels[fe.field] ?? fe.field) : fe.message;
+}
+
+// describeAppError turns a rejected Handler promise into a message for the user:
+// the envelope message, followed by each field problem for validation errors.
+export function describeAppError(err: unknown): string {
+  const appErr = parseAppError(err);
+  const fields = appErr.details.fields ?? [];
+  if (fields.length === 0) return appErr.message;
+  return `${appErr.message}：${fields.map(fieldErrorMessage).join("、")}`;
+}
@@ -4,3 +4,23 @@ import { twMerge } from "tailwind-merge"
 export function cn(...inputs: ClassValue[]) {
   return twMerge(clsx(inputs))
 }
+
+// toLocalDate turns a card date from the backend into a local Date.
+// All-day dates carry only a calendar day, so they are rebuilt from the
+// date part instead of being shifted by the local UTC offset.
+export function toLocalDate(value: string | null | undefined, allDay: boolean): Date | undefined {
+  if (!value) return undefined
+  if (allDay) {
+    const [y, m, d] = value.slice(0, 10).split("-").map(Number)
+    return new Date(y, m - 1, d)
+  }
+  return new Date(value)
+}
+
+// formatDateOnly renders a local Date as "YYYY-MM-DD" for all-day card dates.
+export function formatDateOnly(date: Date): string {
+  const y = date.getFullYear()
+  const m = String(date.getMonth() + 1).padStart(2, "0")
+  const d = String(date.getDate()).padStart(2, "0")
+  return `${y}-${m}-${d}`
+}
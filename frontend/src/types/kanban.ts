@@ -1,14 +1,31 @@
 export interface Board {
   id: string;
   title: string;
+  start_column_id: string;
+  done_column_id: string;
+  estimate_scale: "fibonacci" | "tshirt" | "hours";
+  priorities: PriorityLevel[];
   created_at: string;
   updated_at: string;
 }
 
+export interface PriorityLevel {
+  key: string;
+  name: string;
+  color: string;
+  weight: number;
+}
+
+export interface Workflow {
+  board_id: string;
+  transitions: Record<string, string[]>;
+}
+
 export interface Column {
   id: string;
   board_id: string;
   title: string;
+  kind: "backlog" | "active" | "done";
   position: number;
   created_at: string;
 }
@@ -18,26 +35,151 @@ export interface Card {
   column_id: string;
   title: string;
   description: string;
-  priority: "low" | "medium" | "high";
-  due_date: string | null;
+  priority: string;
+  start_date: string | null;
+  due_at: string | null;
+  all_day: boolean;
+  estimate: number | null;
+  position: number;
+  archived_at: string | null;
+  completed_at: string | null;
+  assignee_ids: string[];
+  custom_fields: Record<string, CustomFieldValue>;
+  created_at: string;
+  updated_at: string;
+}
+
+export interface QuickAddDiagnostic {
+  token: string;
+  kind: "priority" | "label" | "assignee" | "due" | "estimate";
+  applied: boolean;
+  value?: string;
+  message?: string;
+}
+
+export interface QuickAddResult {
+  card: Card;
+  diagnostics: QuickAddDiagnostic[];
+}
+
+export interface Person {
+  id: string;
+  name: string;
+  initials: string;
+  color: string;
+  email: string;
+  created_at: string;
+}
+
+export type CustomFieldType =
+  | "text"
+  | "number"
+  | "date"
+  | "select"
+  | "multi_select"
+  | "checkbox"
+  | "url";
+
+export type CustomFieldValue = string | number | boolean | string[];
+
+export interface CustomField {
+  id: string;
+  board_id: string;
+  name: string;
+  type: CustomFieldType;
+  options: string[];
   position: number;
   created_at: string;
+}
+
+export type AutomationTriggerKind = "card_created" | "card_moved" | "due_passed";
+
+export interface AutomationCondition {
+  kind: "column" | "priority" | "field" | "title_contains";
+  column_id?: string;
+  field_id?: string;
+  value?: CustomFieldValue | null;
+}
+
+export interface AutomationAction {
+  kind: "set_priority" | "move_to_column" | "set_field" | "add_field_option";
+  column_id?: string;
+  field_id?: string;
+  value?: CustomFieldValue | null;
+}
+
+export interface AutomationRuleInput {
+  name: string;
+  enabled: boolean;
+  trigger: { kind: AutomationTriggerKind; column_id?: string };
+  conditions: AutomationCondition[];
+  actions: AutomationAction[];
+}
+
+export interface AutomationRule extends AutomationRuleInput {
+  id: string;
+  board_id: string;
+  created_at: string;
   updated_at: string;
 }
 
+export interface AutomationRun {
+  id: string;
+  rule_id: string;
+  rule_name: string;
+  board_id: string;
+  card_id: string;
+  trigger: AutomationTriggerKind;
+  status: "succeeded" | "failed" | "loop_blocked";
+  message: string;
+  ran_at: string;
+}
+
+export interface Script {
+  name: string;
+  functions: string[];
+  error?: string;
+  last_error?: string;
+}
+
+export interface ScriptCommand {
+  script: string;
+  function: string;
+  label: string;
+}
+
+export interface ScriptResult {
+  value: unknown;
+  output: string[];
+}
+
+export interface TimeEntry {
+  id: string;
+  card_id: string;
+  started_at: string;
+  ended_at: string | null;
+  seconds: number;
+  note: string;
+  created_at: string;
+}
+
 export interface CardUpdate {
   title?: string;
   description?: string;
-  priority?: "low" | "medium" | "high";
-  due_date?: string | null;
+  priority?: string;
+  start_date?: string | null;
+  due_at?: string | null;
+  estimate?: string;
 }
 
 export interface ColumnWithCards {
   column: Column;
   cards: Card[];
+  estimate_total: number;
 }
 
 export interface BoardData {
   board: Board;
   columns: ColumnWithCards[];
+  estimate_total: number;
 }
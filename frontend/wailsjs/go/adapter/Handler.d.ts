@@ -1,37 +1,172 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 import {domain} from '../models';
+import {adapter} from '../models';
 import {application} from '../models';
 import {context} from '../models';
 
+export function AddTimeEntry(arg1:string,arg2:domain.TimeEntryInput):Promise<domain.TimeEntry>;
+
+export function BulkArchive(arg1:Array<string>):Promise<adapter.BulkResponse>;
+
+export function BulkDeleteCards(arg1:Array<string>):Promise<adapter.BulkResponse>;
+
+export function BulkMoveCards(arg1:Array<string>,arg2:string):Promise<adapter.BulkResponse>;
+
+export function BulkUpdateCards(arg1:Array<string>,arg2:domain.CardUpdate):Promise<adapter.BulkResponse>;
+
+export function CreateAutomation(arg1:string,arg2:domain.AutomationRuleInput):Promise<domain.AutomationRule>;
+
 export function CreateBoard(arg1:string):Promise<domain.Board>;
 
+export function CreateBoardFromTemplate(arg1:string,arg2:string):Promise<domain.Board>;
+
 export function CreateCard(arg1:string,arg2:string):Promise<domain.Card>;
 
+export function CreateCardFromTemplate(arg1:string,arg2:string,arg3:Record<string, string>):Promise<domain.Card>;
+
+export function CreateCardTemplate(arg1:string,arg2:domain.CardTemplateInput):Promise<domain.CardTemplate>;
+
 export function CreateColumn(arg1:string,arg2:string):Promise<domain.Column>;
 
+export function CreateCustomField(arg1:string,arg2:domain.CustomFieldInput):Promise<domain.CustomField>;
+
+export function CreatePerson(arg1:domain.PersonInput):Promise<domain.Person>;
+
+export function CreateRecurrence(arg1:domain.RecurrenceInput):Promise<domain.Recurrence>;
+
+export function DeleteAutomation(arg1:string):Promise<void>;
+
 export function DeleteBoard(arg1:string):Promise<void>;
 
+export function DeleteBoardTemplate(arg1:string):Promise<void>;
+
 export function DeleteCard(arg1:string):Promise<void>;
 
+export function DeleteCardTemplate(arg1:string):Promise<void>;
+
 export function DeleteColumn(arg1:string,arg2:string):Promise<void>;
 
-export function FilterCards(arg1:string,arg2:string):Promise<application.BoardData>;
+export function DeleteCustomField(arg1:string):Promise<void>;
+
+export function DeletePerson(arg1:string):Promise<void>;
+
+export function DeleteRecurrence(arg1:string):Promise<void>;
+
+export function DeleteTimeEntry(arg1:string):Promise<void>;
+
+export function DuplicateBoard(arg1:string,arg2:string,arg3:boolean):Promise<domain.Board>;
+
+export function DuplicateCard(arg1:string,arg2:string):Promise<domain.Card>;
+
+export function DuplicateColumn(arg1:string):Promise<domain.Column>;
+
+export function ExportTimesheetCSV(arg1:string,arg2:string,arg3:string):Promise<string>;
+
+export function FilterCards(arg1:string,arg2:string,arg3:string,arg4:Array<domain.FieldFilter>):Promise<application.BoardData>;
+
+export function ForceMoveCard(arg1:string,arg2:string,arg3:number):Promise<void>;
+
+export function ForecastCardsByDate(arg1:string,arg2:string,arg3:string,arg4:number,arg5:number):Promise<domain.CardsForecast>;
+
+export function ForecastCompletion(arg1:string,arg2:string,arg3:number,arg4:number,arg5:number):Promise<domain.CompletionForecast>;
 
 export function GetAllBoards():Promise<Array<domain.Board>>;
 
+export function GetAutomationRuns(arg1:string,arg2:number):Promise<Array<domain.AutomationRun>>;
+
+export function GetAutomations(arg1:string):Promise<Array<domain.AutomationRule>>;
+
+export function GetBoardStats(arg1:string):Promise<domain.BoardStats>;
+
 export function GetBoardWithData(arg1:string):Promise<application.BoardData>;
 
+export function GetCardHistory(arg1:string):Promise<Array<domain.CardEvent>>;
+
+export function GetCardTime(arg1:string):Promise<domain.CardTimeLog>;
+
+export function GetCumulativeFlow(arg1:string,arg2:string,arg3:string,arg4:string):Promise<domain.CumulativeFlow>;
+
+export function GetCustomFields(arg1:string):Promise<Array<domain.CustomField>>;
+
+export function GetErrorMessages(arg1:string):Promise<Record<string, string>>;
+
+export function GetEstimateScales():Promise<Array<domain.EstimateScale>>;
+
+export function GetFlowMetrics(arg1:string,arg2:domain.FlowMetricsFilter):Promise<domain.FlowMetrics>;
+
+export function GetPeople():Promise<Array<domain.Person>>;
+
+export function GetReminderSettings():Promise<domain.ReminderSettings>;
+
+export function GetRunningTimer():Promise<domain.TimeEntry>;
+
+export function GetScriptCommands():Promise<Array<domain.ScriptCommand>>;
+
+export function GetScripts():Promise<Array<domain.Script>>;
+
+export function GetTimeReport(arg1:string,arg2:string,arg3:string):Promise<domain.TimeReport>;
+
+export function GetWorkflow(arg1:string):Promise<domain.Workflow>;
+
+export function ListBoardTemplates():Promise<Array<domain.BoardTemplate>>;
+
+export function ListCardTemplates(arg1:string):Promise<Array<domain.CardTemplate>>;
+
+export function ListRecurrences(arg1:string):Promise<Array<domain.Recurrence>>;
+
 export function MoveCard(arg1:string,arg2:string,arg3:number):Promise<void>;
 
+export function MoveCardToBoard(arg1:string,arg2:string,arg3:string):Promise<domain.Card>;
+
 export function MoveColumn(arg1:string,arg2:number):Promise<void>;
 
-export function SearchCards(arg1:string,arg2:string):Promise<Array<domain.Card>>;
+export function QuickAddCard(arg1:string,arg2:string):Promise<domain.QuickAddResult>;
+
+export function RunScriptCommand(arg1:string,arg2:string,arg3:string,arg4:string):Promise<domain.ScriptResult>;
+
+export function SaveBoardAsTemplate(arg1:string,arg2:string,arg3:boolean):Promise<domain.BoardTemplate>;
+
+export function SearchCards(arg1:string,arg2:string,arg3:string):Promise<Array<domain.Card>>;
 
 export function SeedIfEmpty(arg1:context.Context):Promise<void>;
 
+export function SetBoardEstimateScale(arg1:string,arg2:string):Promise<domain.Board>;
+
+export function SetBoardFlowColumns(arg1:string,arg2:string,arg3:string):Promise<domain.Board>;
+
+export function SetBoardPriorities(arg1:string,arg2:Array<domain.PriorityLevel>):Promise<domain.Board>;
+
+export function SetCardAssignees(arg1:string,arg2:Array<string>):Promise<domain.Card>;
+
+export function SetCardCustomField(arg1:string,arg2:string,arg3:any):Promise<domain.Card>;
+
+export function SetColumnKind(arg1:string,arg2:string):Promise<domain.Column>;
+
+export function SetLocale(arg1:string):Promise<void>;
+
+export function SetWorkflow(arg1:string,arg2:Record<string, Array<string>>):Promise<domain.Workflow>;
+
+export function SnoozeReminder(arg1:string,arg2:number):Promise<void>;
+
+export function StartTimer(arg1:string,arg2:string):Promise<domain.TimeEntry>;
+
+export function StopTimer():Promise<domain.TimeEntry>;
+
+export function UndoBulk():Promise<adapter.BulkResponse>;
+
+export function UpdateAutomation(arg1:string,arg2:domain.AutomationRuleInput):Promise<domain.AutomationRule>;
+
 export function UpdateBoard(arg1:string,arg2:string):Promise<domain.Board>;
 
 export function UpdateCard(arg1:string,arg2:domain.CardUpdate):Promise<domain.Card>;
 
+export function UpdateCardTemplate(arg1:string,arg2:domain.CardTemplateInput):Promise<domain.CardTemplate>;
+
 export function UpdateColumn(arg1:string,arg2:string):Promise<domain.Column>;
+
+export function UpdateCustomField(arg1:string,arg2:domain.CustomFieldInput):Promise<domain.CustomField>;
+
+export function UpdatePerson(arg1:string,arg2:domain.PersonInput):Promise<domain.Person>;
+
+export function UpdateReminderSettings(arg1:domain.ReminderSettings):Promise<domain.ReminderSettings>;
@@ -2,58 +2,310 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function AddTimeEntry(arg1, arg2) {
+  return window['go']['adapter']['Handler']['AddTimeEntry'](arg1, arg2);
+}
+
+export function BulkArchive(arg1) {
+  return window['go']['adapter']['Handler']['BulkArchive'](arg1);
+}
+
+export function BulkDeleteCards(arg1) {
+  return window['go']['adapter']['Handler']['BulkDeleteCards'](arg1);
+}
+
+export function BulkMoveCards(arg1, arg2) {
+  return window['go']['adapter']['Handler']['BulkMoveCards'](arg1, arg2);
+}
+
+export function BulkUpdateCards(arg1, arg2) {
+  return window['go']['adapter']['Handler']['BulkUpdateCards'](arg1, arg2);
+}
+
+export function CreateAutomation(arg1, arg2) {
+  return window['go']['adapter']['Handler']['CreateAutomation'](arg1, arg2);
+}
+
 export function CreateBoard(arg1) {
   return window['go']['adapter']['Handler']['CreateBoard'](arg1);
 }
 
+export function CreateBoardFromTemplate(arg1, arg2) {
+  return window['go']['adapter']['Handler']['CreateBoardFromTemplate'](arg1, arg2);
+}
+
 export function CreateCard(arg1, arg2) {
   return window['go']['adapter']['Handler']['CreateCard'](arg1, arg2);
 }
 
+export function CreateCardFromTemplate(arg1, arg2, arg3) {
+  return window['go']['adapter']['Handler']['CreateCardFromTemplate'](arg1, arg2, arg3);
+}
+
+export function CreateCardTemplate(arg1, arg2) {
+  return window['go']['adapter']['Handler']['CreateCardTemplate'](arg1, arg2);
+}
+
 export function CreateColumn(arg1, arg2) {
   return window['go']['adapter']['Handler']['CreateColumn'](arg1, arg2);
 }
 
+export function CreateCustomField(arg1, arg2) {
+  return window['go']['adapter']['Handler']['CreateCustomField'](arg1, arg2);
+}
+
+export function CreatePerson(arg1) {
+  return window['go']['adapter']['Handler']['CreatePerson'](arg1);
+}
+
+export function CreateRecurrence(arg1) {
+  return window['go']['adapter']['Handler']['CreateRecurrence'](arg1);
+}
+
+export function DeleteAutomation(arg1) {
+  return window['go']['adapter']['Handler']['DeleteAutomation'](arg1);
+}
+
 export function DeleteBoard(arg1) {
   return window['go']['adapter']['Handler']['DeleteBoard'](arg1);
 }
 
+export function DeleteBoardTemplate(arg1) {
+  return window['go']['adapter']['Handler']['DeleteBoardTemplate'](arg1);
+}
+
 export function DeleteCard(arg1) {
   return window['go']['adapter']['Handler']['DeleteCard'](arg1);
 }
 
+export function DeleteCardTemplate(arg1) {
+  return window['go']['adapter']['Handler']['DeleteCardTemplate'](arg1);
+}
+
 export function DeleteColumn(arg1, arg2) {
   return window['go']['adapter']['Handler']['DeleteColumn'](arg1, arg2);
 }
 
-export function FilterCards(arg1, arg2) {
-  return window['go']['adapter']['Handler']['FilterCards'](arg1, arg2);
+export function DeleteCustomField(arg1) {
+  return window['go']['adapter']['Handler']['DeleteCustomField'](arg1);
+}
+
+export function DeletePerson(arg1) {
+  return window['go']['adapter']['Handler']['DeletePerson'](arg1);
+}
+
+export function DeleteRecurrence(arg1) {
+  return window['go']['adapter']['Handler']['DeleteRecurrence'](arg1);
+}
+
+export function DeleteTimeEntry(arg1) {
+  return window['go']['adapter']['Handler']['DeleteTimeEntry'](arg1);
+}
+
+export function DuplicateBoard(arg1, arg2, arg3) {
+  return window['go']['adapter']['Handler']['DuplicateBoard'](arg1, arg2, arg3);
+}
+
+export function DuplicateCard(arg1, arg2) {
+  return window['go']['adapter']['Handler']['DuplicateCard'](arg1, arg2);
+}
+
+export function DuplicateColumn(arg1) {
+  return window['go']['adapter']['Handler']['DuplicateColumn'](arg1);
+}
+
+export function ExportTimesheetCSV(arg1, arg2, arg3) {
+  return window['go']['adapter']['Handler']['ExportTimesheetCSV'](arg1, arg2, arg3);
+}
+
+export function FilterCards(arg1, arg2, arg3, arg4) {
+  return window['go']['adapter']['Handler']['FilterCards'](arg1, arg2, arg3, arg4);
+}
+
+export function ForceMoveCard(arg1, arg2, arg3) {
+  return window['go']['adapter']['Handler']['ForceMoveCard'](arg1, arg2, arg3);
+}
+
+export function ForecastCardsByDate(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['adapter']['Handler']['ForecastCardsByDate'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function ForecastCompletion(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['adapter']['Handler']['ForecastCompletion'](arg1, arg2, arg3, arg4, arg5);
 }
 
 export function GetAllBoards() {
   return window['go']['adapter']['Handler']['GetAllBoards']();
 }
 
+export function GetAutomationRuns(arg1, arg2) {
+  return window['go']['adapter']['Handler']['GetAutomationRuns'](arg1, arg2);
+}
+
+export function GetAutomations(arg1) {
+  return window['go']['adapter']['Handler']['GetAutomations'](arg1);
+}
+
+export function GetBoardStats(arg1) {
+  return window['go']['adapter']['Handler']['GetBoardStats'](arg1);
+}
+
 export function GetBoardWithData(arg1) {
   return window['go']['adapter']['Handler']['GetBoardWithData'](arg1);
 }
 
+export function GetCardHistory(arg1) {
+  return window['go']['adapter']['Handler']['GetCardHistory'](arg1);
+}
+
+export function GetCardTime(arg1) {
+  return window['go']['adapter']['Handler']['GetCardTime'](arg1);
+}
+
+export function GetCumulativeFlow(arg1, arg2, arg3, arg4) {
+  return window['go']['adapter']['Handler']['GetCumulativeFlow'](arg1, arg2, arg3, arg4);
+}
+
+export function GetCustomFields(arg1) {
+  return window['go']['adapter']['Handler']['GetCustomFields'](arg1);
+}
+
+export function GetErrorMessages(arg1) {
+  return window['go']['adapter']['Handler']['GetErrorMessages'](arg1);
+}
+
+export function GetEstimateScales() {
+  return window['go']['adapter']['Handler']['GetEstimateScales']();
+}
+
+export function GetFlowMetrics(arg1, arg2) {
+  return window['go']['adapter']['Handler']['GetFlowMetrics'](arg1, arg2);
+}
+
+export function GetPeople() {
+  return window['go']['adapter']['Handler']['GetPeople']();
+}
+
+export function GetReminderSettings() {
+  return window['go']['adapter']['Handler']['GetReminderSettings']();
+}
+
+export function GetRunningTimer() {
+  return window['go']['adapter']['Handler']['GetRunningTimer']();
+}
+
+export function GetScriptCommands() {
+  return window['go']['adapter']['Handler']['GetScriptCommands']();
+}
+
+export function GetScripts() {
+  return window['go']['adapter']['Handler']['GetScripts']();
+}
+
+export function GetTimeReport(arg1, arg2, arg3) {
+  return window['go']['adapter']['Handler']['GetTimeReport'](arg1, arg2, arg3);
+}
+
+export function GetWorkflow(arg1) {
+  return window['go']['adapter']['Handler']['GetWorkflow'](arg1);
+}
+
+export function ListBoardTemplates() {
+  return window['go']['adapter']['Handler']['ListBoardTemplates']();
+}
+
+export function ListCardTemplates(arg1) {
+  return window['go']['adapter']['Handler']['ListCardTemplates'](arg1);
+}
+
+export function ListRecurrences(arg1) {
+  return window['go']['adapter']['Handler']['ListRecurrences'](arg1);
+}
+
 export function MoveCard(arg1, arg2, arg3) {
   return window['go']['adapter']['Handler']['MoveCard'](arg1, arg2, arg3);
 }
 
+export function MoveCardToBoard(arg1, arg2, arg3) {
+  return window['go']['adapter']['Handler']['MoveCardToBoard'](arg1, arg2, arg3);
+}
+
 export function MoveColumn(arg1, arg2) {
   return window['go']['adapter']['Handler']['MoveColumn'](arg1, arg2);
 }
 
-export function SearchCards(arg1, arg2) {
-  return window['go']['adapter']['Handler']['SearchCards'](arg1, arg2);
+export function QuickAddCard(arg1, arg2) {
+  return window['go']['adapter']['Handler']['QuickAddCard'](arg1, arg2);
+}
+
+export function RunScriptCommand(arg1, arg2, arg3, arg4) {
+  return window['go']['adapter']['Handler']['RunScriptCommand'](arg1, arg2, arg3, arg4);
+}
+
+export function SaveBoardAsTemplate(arg1, arg2, arg3) {
+  return window['go']['adapter']['Handler']['SaveBoardAsTemplate'](arg1, arg2, arg3);
+}
+
+export function SearchCards(arg1, arg2, arg3) {
+  return window['go']['adapter']['Handler']['SearchCards'](arg1, arg2, arg3);
 }
 
 export function SeedIfEmpty(arg1) {
   return window['go']['adapter']['Handler']['SeedIfEmpty'](arg1);
 }
 
+export function SetBoardEstimateScale(arg1, arg2) {
+  return window['go']['adapter']['Handler']['SetBoardEstimateScale'](arg1, arg2);
+}
+
+export function SetBoardFlowColumns(arg1, arg2, arg3) {
+  return window['go']['adapter']['Handler']['SetBoardFlowColumns'](arg1, arg2, arg3);
+}
+
+export function SetBoardPriorities(arg1, arg2) {
+  return window['go']['adapter']['Handler']['SetBoardPriorities'](arg1, arg2);
+}
+
+export function SetCardAssignees(arg1, arg2) {
+  return window['go']['adapter']['Handler']['SetCardAssignees'](arg1, arg2);
+}
+
+export function SetCardCustomField(arg1, arg2, arg3) {
+  return window['go']['adapter']['Handler']['SetCardCustomField'](arg1, arg2, arg3);
+}
+
+export function SetColumnKind(arg1, arg2) {
+  return window['go']['adapter']['Handler']['SetColumnKind'](arg1, arg2);
+}
+
+export function SetLocale(arg1) {
+  return window['go']['adapter']['Handler']['SetLocale'](arg1);
+}
+
+export function SetWorkflow(arg1, arg2) {
+  return window['go']['adapter']['Handler']['SetWorkflow'](arg1, arg2);
+}
+
+export function SnoozeReminder(arg1, arg2) {
+  return window['go']['adapter']['Handler']['SnoozeReminder'](arg1, arg2);
+}
+
+export function StartTimer(arg1, arg2) {
+  return window['go']['adapter']['Handler']['StartTimer'](arg1, arg2);
+}
+
+export function StopTimer() {
+  return window['go']['adapter']['Handler']['StopTimer']();
+}
+
+export function UndoBulk() {
+  return window['go']['adapter']['Handler']['UndoBulk']();
+}
+
+export function UpdateAutomation(arg1, arg2) {
+  return window['go']['adapter']['Handler']['UpdateAutomation'](arg1, arg2);
+}
+
 export function UpdateBoard(arg1, arg2) {
   return window['go']['adapter']['Handler']['UpdateBoard'](arg1, arg2);
 }
@@ -62,6 +314,22 @@ export function UpdateCard(arg1, arg2) {
   return window['go']['adapter']['Handler']['UpdateCard'](arg1, arg2);
 }
 
+export function UpdateCardTemplate(arg1, arg2) {
+  return window['go']['adapter']['Handler']['UpdateCardTemplate'](arg1, arg2);
+}
+
 export function UpdateColumn(arg1, arg2) {
   return window['go']['adapter']['Handler']['UpdateColumn'](arg1, arg2);
 }
+
+export function UpdateCustomField(arg1, arg2) {
+  return window['go']['adapter']['Handler']['UpdateCustomField'](arg1, arg2);
+}
+
+export function UpdatePerson(arg1, arg2) {
+  return window['go']['adapter']['Handler']['UpdatePerson'](arg1, arg2);
+}
+
+export function UpdateReminderSettings(arg1) {
+  return window['go']['adapter']['Handler']['UpdateReminderSettings'](arg1);
+}
@@ -1,8 +1,147 @@
+export namespace adapter {
+	
+	export class ErrorDetails {
+	    error: string;
+	    fields?: domain.FieldError[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ErrorDetails(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.error = source["error"];
+	        this.fields = this.convertValues(source["fields"], domain.FieldError);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ErrorEnvelope {
+	    code: string;
+	    message: string;
+	    details: ErrorDetails;
+	
+	    static createFrom(source: any = {}) {
+	        return new ErrorEnvelope(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.code = source["code"];
+	        this.message = source["message"];
+	        this.details = this.convertValues(source["details"], ErrorDetails);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class BulkItemResponse {
+	    id: string;
+	    ok: boolean;
+	    error?: ErrorEnvelope;
+	
+	    static createFrom(source: any = {}) {
+	        return new BulkItemResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.ok = source["ok"];
+	        this.error = this.convertValues(source["error"], ErrorEnvelope);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class BulkResponse {
+	    items: BulkItemResponse[];
+	    board_ids: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new BulkResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.items = this.convertValues(source["items"], BulkItemResponse);
+	        this.board_ids = source["board_ids"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+
+}
+
 export namespace application {
 	
 	export class ColumnWithCards {
 	    column: domain.Column;
 	    cards: domain.Card[];
+	    estimate_total: number;
 	
 	    static createFrom(source: any = {}) {
 	        return new ColumnWithCards(source);
@@ -12,6 +151,7 @@ export namespace application {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.column = this.convertValues(source["column"], domain.Column);
 	        this.cards = this.convertValues(source["cards"], domain.Card);
+	        this.estimate_total = source["estimate_total"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -35,6 +175,7 @@ export namespace application {
 	export class BoardData {
 	    board: domain.Board;
 	    columns: ColumnWithCards[];
+	    estimate_total: number;
 	
 	    static createFrom(source: any = {}) {
 	        return new BoardData(source);
@@ -44,6 +185,7 @@ export namespace application {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.board = this.convertValues(source["board"], domain.Board);
 	        this.columns = this.convertValues(source["columns"], ColumnWithCards);
+	        this.estimate_total = source["estimate_total"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -69,24 +211,41 @@ export namespace application {
 
 export namespace domain {
 	
-	export class Board {
-	    id: string;
+	export class ActivityCount {
+	    last_7_days: number;
+	    last_30_days: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ActivityCount(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.last_7_days = source["last_7_days"];
+	        this.last_30_days = source["last_30_days"];
+	    }
+	}
+	export class AgingCard {
+	    card_id: string;
 	    title: string;
+	    priority: string;
+	    column_id: string;
 	    // Go type: time
-	    created_at: any;
-	    // Go type: time
-	    updated_at: any;
+	    started_at: any;
+	    age_days: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new Board(source);
+	        return new AgingCard(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
+	        this.card_id = source["card_id"];
 	        this.title = source["title"];
-	        this.created_at = this.convertValues(source["created_at"], null);
-	        this.updated_at = this.convertValues(source["updated_at"], null);
+	        this.priority = source["priority"];
+	        this.column_id = source["column_id"];
+	        this.started_at = this.convertValues(source["started_at"], null);
+	        this.age_days = source["age_days"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -107,33 +266,100 @@ export namespace domain {
 		    return a;
 		}
 	}
-	export class Card {
+	export class AssigneeLoad {
+	    person_id: string;
+	    name: string;
+	    open: number;
+	    overdue: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new AssigneeLoad(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.person_id = source["person_id"];
+	        this.name = source["name"];
+	        this.open = source["open"];
+	        this.overdue = source["overdue"];
+	    }
+	}
+	export class AutomationAction {
+	    kind: string;
+	    column_id?: string;
+	    field_id?: string;
+	    value?: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new AutomationAction(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.column_id = source["column_id"];
+	        this.field_id = source["field_id"];
+	        this.value = source["value"];
+	    }
+	}
+	export class AutomationCondition {
+	    kind: string;
+	    column_id?: string;
+	    field_id?: string;
+	    value?: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new AutomationCondition(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.column_id = source["column_id"];
+	        this.field_id = source["field_id"];
+	        this.value = source["value"];
+	    }
+	}
+	export class AutomationTrigger {
+	    kind: string;
+	    column_id?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new AutomationTrigger(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.column_id = source["column_id"];
+	    }
+	}
+	export class AutomationRule {
 	    id: string;
-	    column_id: string;
-	    title: string;
-	    description: string;
-	    priority: string;
-	    // Go type: time
-	    due_date?: any;
-	    position: number;
+	    board_id: string;
+	    name: string;
+	    enabled: boolean;
+	    trigger: AutomationTrigger;
+	    conditions: AutomationCondition[];
+	    actions: AutomationAction[];
 	    // Go type: time
 	    created_at: any;
 	    // Go type: time
 	    updated_at: any;
 	
 	    static createFrom(source: any = {}) {
-	        return new Card(source);
+	        return new AutomationRule(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
-	        this.column_id = source["column_id"];
-	        this.title = source["title"];
-	        this.description = source["description"];
-	        this.priority = source["priority"];
-	        this.due_date = this.convertValues(source["due_date"], null);
-	        this.position = source["position"];
+	        this.board_id = source["board_id"];
+	        this.name = source["name"];
+	        this.enabled = source["enabled"];
+	        this.trigger = this.convertValues(source["trigger"], AutomationTrigger);
+	        this.conditions = this.convertValues(source["conditions"], AutomationCondition);
+	        this.actions = this.convertValues(source["actions"], AutomationAction);
 	        this.created_at = this.convertValues(source["created_at"], null);
 	        this.updated_at = this.convertValues(source["updated_at"], null);
 	    }
@@ -156,43 +382,71 @@ export namespace domain {
 		    return a;
 		}
 	}
-	export class CardUpdate {
-	    title?: string;
-	    description?: string;
-	    priority?: string;
-	    due_date?: string;
+	export class AutomationRuleInput {
+	    name: string;
+	    enabled: boolean;
+	    trigger: AutomationTrigger;
+	    conditions: AutomationCondition[];
+	    actions: AutomationAction[];
 	
 	    static createFrom(source: any = {}) {
-	        return new CardUpdate(source);
+	        return new AutomationRuleInput(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.title = source["title"];
-	        this.description = source["description"];
-	        this.priority = source["priority"];
-	        this.due_date = source["due_date"];
+	        this.name = source["name"];
+	        this.enabled = source["enabled"];
+	        this.trigger = this.convertValues(source["trigger"], AutomationTrigger);
+	        this.conditions = this.convertValues(source["conditions"], AutomationCondition);
+	        this.actions = this.convertValues(source["actions"], AutomationAction);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class Column {
+	export class AutomationRun {
 	    id: string;
+	    rule_id: string;
+	    rule_name: string;
 	    board_id: string;
-	    title: string;
-	    position: number;
+	    card_id: string;
+	    trigger: string;
+	    status: string;
+	    message: string;
 	    // Go type: time
-	    created_at: any;
+	    ran_at: any;
 	
 	    static createFrom(source: any = {}) {
-	        return new Column(source);
+	        return new AutomationRun(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.id = source["id"];
+	        this.rule_id = source["rule_id"];
+	        this.rule_name = source["rule_name"];
 	        this.board_id = source["board_id"];
-	        this.title = source["title"];
-	        this.position = source["position"];
-	        this.created_at = this.convertValues(source["created_at"], null);
+	        this.card_id = source["card_id"];
+	        this.trigger = source["trigger"];
+	        this.status = source["status"];
+	        this.message = source["message"];
+	        this.ran_at = this.convertValues(source["ran_at"], null);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -213,6 +467,1504 @@ export namespace domain {
 		    return a;
 		}
 	}
+	
+	export class PriorityLevel {
+	    key: string;
+	    name: string;
+	    color: string;
+	    weight: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PriorityLevel(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.key = source["key"];
+	        this.name = source["name"];
+	        this.color = source["color"];
+	        this.weight = source["weight"];
+	    }
+	}
+	export class Board {
+	    id: string;
+	    title: string;
+	    start_column_id: string;
+	    done_column_id: string;
+	    estimate_scale: string;
+	    priorities: PriorityLevel[];
+	    // Go type: time
+	    created_at: any;
+	    // Go type: time
+	    updated_at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new Board(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.title = source["title"];
+	        this.start_column_id = source["start_column_id"];
+	        this.done_column_id = source["done_column_id"];
+	        this.estimate_scale = source["estimate_scale"];
+	        this.priorities = this.convertValues(source["priorities"], PriorityLevel);
+	        this.created_at = this.convertValues(source["created_at"], null);
+	        this.updated_at = this.convertValues(source["updated_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CardSummary {
+	    id: string;
+	    title: string;
+	    column_id: string;
+	    // Go type: time
+	    created_at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new CardSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.title = source["title"];
+	        this.column_id = source["column_id"];
+	        this.created_at = this.convertValues(source["created_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PriorityCount {
+	    key: string;
+	    name: string;
+	    color: string;
+	    count: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PriorityCount(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.key = source["key"];
+	        this.name = source["name"];
+	        this.color = source["color"];
+	        this.count = source["count"];
+	    }
+	}
+	export class ColumnCount {
+	    column_id: string;
+	    title: string;
+	    count: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ColumnCount(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.column_id = source["column_id"];
+	        this.title = source["title"];
+	        this.count = source["count"];
+	    }
+	}
+	export class BoardStats {
+	    board_id: string;
+	    total_cards: number;
+	    open_cards: number;
+	    by_column: ColumnCount[];
+	    by_priority: PriorityCount[];
+	    overdue: number;
+	    due_this_week: number;
+	    no_due_date: number;
+	    oldest_open_card?: CardSummary;
+	    created: ActivityCount;
+	    completed: ActivityCount;
+	    by_assignee: AssigneeLoad[];
+	    unassigned_open: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new BoardStats(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.board_id = source["board_id"];
+	        this.total_cards = source["total_cards"];
+	        this.open_cards = source["open_cards"];
+	        this.by_column = this.convertValues(source["by_column"], ColumnCount);
+	        this.by_priority = this.convertValues(source["by_priority"], PriorityCount);
+	        this.overdue = source["overdue"];
+	        this.due_this_week = source["due_this_week"];
+	        this.no_due_date = source["no_due_date"];
+	        this.oldest_open_card = this.convertValues(source["oldest_open_card"], CardSummary);
+	        this.created = this.convertValues(source["created"], ActivityCount);
+	        this.completed = this.convertValues(source["completed"], ActivityCount);
+	        this.by_assignee = this.convertValues(source["by_assignee"], AssigneeLoad);
+	        this.unassigned_open = source["unassigned_open"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CustomFieldInput {
+	    name: string;
+	    type: string;
+	    options: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CustomFieldInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.type = source["type"];
+	        this.options = source["options"];
+	    }
+	}
+	export class TemplateCard {
+	    column_index: number;
+	    title: string;
+	    description: string;
+	    priority: string;
+	    field_values?: Record<number, any>;
+	
+	    static createFrom(source: any = {}) {
+	        return new TemplateCard(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.column_index = source["column_index"];
+	        this.title = source["title"];
+	        this.description = source["description"];
+	        this.priority = source["priority"];
+	        this.field_values = source["field_values"];
+	    }
+	}
+	export class TemplateColumn {
+	    title: string;
+	    kind?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new TemplateColumn(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.title = source["title"];
+	        this.kind = source["kind"];
+	    }
+	}
+	export class BoardTemplate {
+	    id: string;
+	    name: string;
+	    description: string;
+	    built_in: boolean;
+	    columns: TemplateColumn[];
+	    cards: TemplateCard[];
+	    priorities?: PriorityLevel[];
+	    fields?: CustomFieldInput[];
+	    transitions?: Record<number, Array<number>>;
+	    estimate_scale?: string;
+	    start_column?: number;
+	    done_column?: number;
+	    // Go type: time
+	    created_at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new BoardTemplate(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.description = source["description"];
+	        this.built_in = source["built_in"];
+	        this.columns = this.convertValues(source["columns"], TemplateColumn);
+	        this.cards = this.convertValues(source["cards"], TemplateCard);
+	        this.priorities = this.convertValues(source["priorities"], PriorityLevel);
+	        this.fields = this.convertValues(source["fields"], CustomFieldInput);
+	        this.transitions = source["transitions"];
+	        this.estimate_scale = source["estimate_scale"];
+	        this.start_column = source["start_column"];
+	        this.done_column = source["done_column"];
+	        this.created_at = this.convertValues(source["created_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Card {
+	    id: string;
+	    column_id: string;
+	    title: string;
+	    description: string;
+	    priority: string;
+	    // Go type: time
+	    start_date?: any;
+	    // Go type: time
+	    due_at?: any;
+	    all_day: boolean;
+	    estimate?: number;
+	    position: number;
+	    // Go type: time
+	    archived_at?: any;
+	    // Go type: time
+	    completed_at?: any;
+	    assignee_ids: string[];
+	    custom_fields: Record<string, any>;
+	    // Go type: time
+	    created_at: any;
+	    // Go type: time
+	    updated_at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new Card(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.column_id = source["column_id"];
+	        this.title = source["title"];
+	        this.description = source["description"];
+	        this.priority = source["priority"];
+	        this.start_date = this.convertValues(source["start_date"], null);
+	        this.due_at = this.convertValues(source["due_at"], null);
+	        this.all_day = source["all_day"];
+	        this.estimate = source["estimate"];
+	        this.position = source["position"];
+	        this.archived_at = this.convertValues(source["archived_at"], null);
+	        this.completed_at = this.convertValues(source["completed_at"], null);
+	        this.assignee_ids = source["assignee_ids"];
+	        this.custom_fields = source["custom_fields"];
+	        this.created_at = this.convertValues(source["created_at"], null);
+	        this.updated_at = this.convertValues(source["updated_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CardEvent {
+	    id: number;
+	    card_id: string;
+	    board_id: string;
+	    kind: string;
+	    from_board_id?: string;
+	    from_column_id?: string;
+	    to_column_id?: string;
+	    to_column_title?: string;
+	    // Go type: time
+	    at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new CardEvent(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.card_id = source["card_id"];
+	        this.board_id = source["board_id"];
+	        this.kind = source["kind"];
+	        this.from_board_id = source["from_board_id"];
+	        this.from_column_id = source["from_column_id"];
+	        this.to_column_id = source["to_column_id"];
+	        this.to_column_title = source["to_column_title"];
+	        this.at = this.convertValues(source["at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CardFlowTime {
+	    card_id: string;
+	    title: string;
+	    priority: string;
+	    // Go type: time
+	    created_at: any;
+	    // Go type: time
+	    started_at: any;
+	    // Go type: time
+	    completed_at: any;
+	    lead_time_days: number;
+	    cycle_time_days: number;
+	    estimate?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CardFlowTime(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.card_id = source["card_id"];
+	        this.title = source["title"];
+	        this.priority = source["priority"];
+	        this.created_at = this.convertValues(source["created_at"], null);
+	        this.started_at = this.convertValues(source["started_at"], null);
+	        this.completed_at = this.convertValues(source["completed_at"], null);
+	        this.lead_time_days = source["lead_time_days"];
+	        this.cycle_time_days = source["cycle_time_days"];
+	        this.estimate = source["estimate"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class CardTemplate {
+	    id: string;
+	    board_id: string;
+	    name: string;
+	    title_pattern: string;
+	    description: string;
+	    priority: string;
+	    labels: string[];
+	    checklist: string[];
+	    counter: number;
+	    // Go type: time
+	    created_at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new CardTemplate(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.board_id = source["board_id"];
+	        this.name = source["name"];
+	        this.title_pattern = source["title_pattern"];
+	        this.description = source["description"];
+	        this.priority = source["priority"];
+	        this.labels = source["labels"];
+	        this.checklist = source["checklist"];
+	        this.counter = source["counter"];
+	        this.created_at = this.convertValues(source["created_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CardTemplateInput {
+	    name: string;
+	    title_pattern: string;
+	    description: string;
+	    priority: string;
+	    labels: string[];
+	    checklist: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CardTemplateInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.title_pattern = source["title_pattern"];
+	        this.description = source["description"];
+	        this.priority = source["priority"];
+	        this.labels = source["labels"];
+	        this.checklist = source["checklist"];
+	    }
+	}
+	export class CardTime {
+	    card_id: string;
+	    title: string;
+	    seconds: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CardTime(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.card_id = source["card_id"];
+	        this.title = source["title"];
+	        this.seconds = source["seconds"];
+	    }
+	}
+	export class TimeEntry {
+	    id: string;
+	    card_id: string;
+	    // Go type: time
+	    started_at: any;
+	    // Go type: time
+	    ended_at?: any;
+	    seconds: number;
+	    note: string;
+	    // Go type: time
+	    created_at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new TimeEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.card_id = source["card_id"];
+	        this.started_at = this.convertValues(source["started_at"], null);
+	        this.ended_at = this.convertValues(source["ended_at"], null);
+	        this.seconds = source["seconds"];
+	        this.note = source["note"];
+	        this.created_at = this.convertValues(source["created_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CardTimeLog {
+	    card_id: string;
+	    total_seconds: number;
+	    entries: TimeEntry[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CardTimeLog(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.card_id = source["card_id"];
+	        this.total_seconds = source["total_seconds"];
+	        this.entries = this.convertValues(source["entries"], TimeEntry);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CardUpdate {
+	    title?: string;
+	    description?: string;
+	    priority?: string;
+	    start_date?: string;
+	    due_at?: string;
+	    estimate?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new CardUpdate(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.title = source["title"];
+	        this.description = source["description"];
+	        this.priority = source["priority"];
+	        this.start_date = source["start_date"];
+	        this.due_at = source["due_at"];
+	        this.estimate = source["estimate"];
+	    }
+	}
+	export class CountConfidence {
+	    confidence: number;
+	    amount: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CountConfidence(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.confidence = source["confidence"];
+	        this.amount = source["amount"];
+	    }
+	}
+	export class CountProbability {
+	    amount: number;
+	    probability: number;
+	    at_least: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CountProbability(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.amount = source["amount"];
+	        this.probability = source["probability"];
+	        this.at_least = source["at_least"];
+	    }
+	}
+	export class CardsForecast {
+	    board_id: string;
+	    unit: string;
+	    date: string;
+	    simulations: number;
+	    seed: number;
+	    history_days: number;
+	    distribution: CountProbability[];
+	    confidence: CountConfidence[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CardsForecast(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.board_id = source["board_id"];
+	        this.unit = source["unit"];
+	        this.date = source["date"];
+	        this.simulations = source["simulations"];
+	        this.seed = source["seed"];
+	        this.history_days = source["history_days"];
+	        this.distribution = this.convertValues(source["distribution"], CountProbability);
+	        this.confidence = this.convertValues(source["confidence"], CountConfidence);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Column {
+	    id: string;
+	    board_id: string;
+	    title: string;
+	    kind: string;
+	    position: number;
+	    // Go type: time
+	    created_at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new Column(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.board_id = source["board_id"];
+	        this.title = source["title"];
+	        this.kind = source["kind"];
+	        this.position = source["position"];
+	        this.created_at = this.convertValues(source["created_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class DateConfidence {
+	    confidence: number;
+	    date: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DateConfidence(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.confidence = source["confidence"];
+	        this.date = source["date"];
+	    }
+	}
+	export class DateProbability {
+	    date: string;
+	    probability: number;
+	    cumulative: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DateProbability(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.date = source["date"];
+	        this.probability = source["probability"];
+	        this.cumulative = source["cumulative"];
+	    }
+	}
+	export class CompletionForecast {
+	    board_id: string;
+	    unit: string;
+	    remaining: number;
+	    simulations: number;
+	    seed: number;
+	    history_days: number;
+	    distribution: DateProbability[];
+	    confidence: DateConfidence[];
+	    incomplete: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CompletionForecast(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.board_id = source["board_id"];
+	        this.unit = source["unit"];
+	        this.remaining = source["remaining"];
+	        this.simulations = source["simulations"];
+	        this.seed = source["seed"];
+	        this.history_days = source["history_days"];
+	        this.distribution = this.convertValues(source["distribution"], DateProbability);
+	        this.confidence = this.convertValues(source["confidence"], DateConfidence);
+	        this.incomplete = source["incomplete"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class FlowPoint {
+	    date: string;
+	    counts: number[];
+	
+	    static createFrom(source: any = {}) {
+	        return new FlowPoint(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.date = source["date"];
+	        this.counts = source["counts"];
+	    }
+	}
+	export class FlowColumn {
+	    id: string;
+	    title: string;
+	    deleted: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new FlowColumn(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.title = source["title"];
+	        this.deleted = source["deleted"];
+	    }
+	}
+	export class CumulativeFlow {
+	    board_id: string;
+	    interval: string;
+	    columns: FlowColumn[];
+	    points: FlowPoint[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CumulativeFlow(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.board_id = source["board_id"];
+	        this.interval = source["interval"];
+	        this.columns = this.convertValues(source["columns"], FlowColumn);
+	        this.points = this.convertValues(source["points"], FlowPoint);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CustomField {
+	    id: string;
+	    board_id: string;
+	    name: string;
+	    type: string;
+	    options: string[];
+	    position: number;
+	    // Go type: time
+	    created_at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new CustomField(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.board_id = source["board_id"];
+	        this.name = source["name"];
+	        this.type = source["type"];
+	        this.options = source["options"];
+	        this.position = source["position"];
+	        this.created_at = this.convertValues(source["created_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	
+	export class DateTime {
+	    date: string;
+	    seconds: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DateTime(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.date = source["date"];
+	        this.seconds = source["seconds"];
+	    }
+	}
+	export class EstimateValue {
+	    label: string;
+	    value: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new EstimateValue(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.label = source["label"];
+	        this.value = source["value"];
+	    }
+	}
+	export class EstimateScale {
+	    kind: string;
+	    values: EstimateValue[];
+	
+	    static createFrom(source: any = {}) {
+	        return new EstimateScale(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.values = this.convertValues(source["values"], EstimateValue);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class FieldError {
+	    field: string;
+	    code: string;
+	    message: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new FieldError(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.field = source["field"];
+	        this.code = source["code"];
+	        this.message = source["message"];
+	    }
+	}
+	export class FieldFilter {
+	    field_id: string;
+	    value: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new FieldFilter(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.field_id = source["field_id"];
+	        this.value = source["value"];
+	    }
+	}
+	
+	export class ThroughputPoint {
+	    week_start: string;
+	    count: number;
+	    points: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ThroughputPoint(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.week_start = source["week_start"];
+	        this.count = source["count"];
+	        this.points = source["points"];
+	    }
+	}
+	export class Percentiles {
+	    p50: number;
+	    p85: number;
+	    p95: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Percentiles(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.p50 = source["p50"];
+	        this.p85 = source["p85"];
+	        this.p95 = source["p95"];
+	    }
+	}
+	export class FlowMetrics {
+	    board_id: string;
+	    from: string;
+	    to: string;
+	    start_column_id: string;
+	    done_column_id: string;
+	    cards: CardFlowTime[];
+	    lead_time: Percentiles;
+	    cycle_time: Percentiles;
+	    throughput: ThroughputPoint[];
+	    aging_wip: AgingCard[];
+	
+	    static createFrom(source: any = {}) {
+	        return new FlowMetrics(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.board_id = source["board_id"];
+	        this.from = source["from"];
+	        this.to = source["to"];
+	        this.start_column_id = source["start_column_id"];
+	        this.done_column_id = source["done_column_id"];
+	        this.cards = this.convertValues(source["cards"], CardFlowTime);
+	        this.lead_time = this.convertValues(source["lead_time"], Percentiles);
+	        this.cycle_time = this.convertValues(source["cycle_time"], Percentiles);
+	        this.throughput = this.convertValues(source["throughput"], ThroughputPoint);
+	        this.aging_wip = this.convertValues(source["aging_wip"], AgingCard);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class FlowMetricsFilter {
+	    from: string;
+	    to: string;
+	    priorities: string[];
+	    fields: FieldFilter[];
+	
+	    static createFrom(source: any = {}) {
+	        return new FlowMetricsFilter(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.from = source["from"];
+	        this.to = source["to"];
+	        this.priorities = source["priorities"];
+	        this.fields = this.convertValues(source["fields"], FieldFilter);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class Person {
+	    id: string;
+	    name: string;
+	    initials: string;
+	    color: string;
+	    email: string;
+	    // Go type: time
+	    created_at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new Person(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.initials = source["initials"];
+	        this.color = source["color"];
+	        this.email = source["email"];
+	        this.created_at = this.convertValues(source["created_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PersonInput {
+	    name: string;
+	    initials: string;
+	    color: string;
+	    email: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PersonInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.initials = source["initials"];
+	        this.color = source["color"];
+	        this.email = source["email"];
+	    }
+	}
+	
+	
+	export class QuickAddDiagnostic {
+	    token: string;
+	    kind: string;
+	    applied: boolean;
+	    value?: string;
+	    message?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new QuickAddDiagnostic(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.token = source["token"];
+	        this.kind = source["kind"];
+	        this.applied = source["applied"];
+	        this.value = source["value"];
+	        this.message = source["message"];
+	    }
+	}
+	export class QuickAddResult {
+	    card?: Card;
+	    diagnostics: QuickAddDiagnostic[];
+	
+	    static createFrom(source: any = {}) {
+	        return new QuickAddResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.card = this.convertValues(source["card"], Card);
+	        this.diagnostics = this.convertValues(source["diagnostics"], QuickAddDiagnostic);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Recurrence {
+	    id: string;
+	    board_id: string;
+	    column_id: string;
+	    template_id: string;
+	    title: string;
+	    description: string;
+	    priority: string;
+	    rule: string;
+	    // Go type: time
+	    start_at: any;
+	    all_day: boolean;
+	    mode: string;
+	    catch_up: string;
+	    // Go type: time
+	    next_run_at?: any;
+	    last_card_id: string;
+	    occurrences: number;
+	    // Go type: time
+	    created_at: any;
+	
+	    static createFrom(source: any = {}) {
+	        return new Recurrence(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.board_id = source["board_id"];
+	        this.column_id = source["column_id"];
+	        this.template_id = source["template_id"];
+	        this.title = source["title"];
+	        this.description = source["description"];
+	        this.priority = source["priority"];
+	        this.rule = source["rule"];
+	        this.start_at = this.convertValues(source["start_at"], null);
+	        this.all_day = source["all_day"];
+	        this.mode = source["mode"];
+	        this.catch_up = source["catch_up"];
+	        this.next_run_at = this.convertValues(source["next_run_at"], null);
+	        this.last_card_id = source["last_card_id"];
+	        this.occurrences = source["occurrences"];
+	        this.created_at = this.convertValues(source["created_at"], null);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class RecurrenceInput {
+	    card_id: string;
+	    template_id: string;
+	    column_id: string;
+	    rule: string;
+	    start_at: string;
+	    mode: string;
+	    catch_up: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RecurrenceInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.card_id = source["card_id"];
+	        this.template_id = source["template_id"];
+	        this.column_id = source["column_id"];
+	        this.rule = source["rule"];
+	        this.start_at = source["start_at"];
+	        this.mode = source["mode"];
+	        this.catch_up = source["catch_up"];
+	    }
+	}
+	export class ReminderSettings {
+	    enabled: boolean;
+	    lead_times_minutes: number[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ReminderSettings(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.enabled = source["enabled"];
+	        this.lead_times_minutes = source["lead_times_minutes"];
+	    }
+	}
+	export class Script {
+	    name: string;
+	    functions: string[];
+	    error?: string;
+	    last_error?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new Script(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.functions = source["functions"];
+	        this.error = source["error"];
+	        this.last_error = source["last_error"];
+	    }
+	}
+	export class ScriptCommand {
+	    script: string;
+	    function: string;
+	    label: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ScriptCommand(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.script = source["script"];
+	        this.function = source["function"];
+	        this.label = source["label"];
+	    }
+	}
+	export class ScriptResult {
+	    value: any;
+	    output: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ScriptResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.value = source["value"];
+	        this.output = source["output"];
+	    }
+	}
+	
+	
+	
+	
+	export class TimeEntryInput {
+	    started_at: string;
+	    ended_at: string;
+	    note: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new TimeEntryInput(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.started_at = source["started_at"];
+	        this.ended_at = source["ended_at"];
+	        this.note = source["note"];
+	    }
+	}
+	export class TimeReport {
+	    board_id: string;
+	    from: string;
+	    to: string;
+	    total_seconds: number;
+	    by_card: CardTime[];
+	    by_date: DateTime[];
+	
+	    static createFrom(source: any = {}) {
+	        return new TimeReport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.board_id = source["board_id"];
+	        this.from = source["from"];
+	        this.to = source["to"];
+	        this.total_seconds = source["total_seconds"];
+	        this.by_card = this.convertValues(source["by_card"], CardTime);
+	        this.by_date = this.convertValues(source["by_date"], DateTime);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Workflow {
+	    board_id: string;
+	    transitions: Record<string, Array<string>>;
+	
+	    static createFrom(source: any = {}) {
+	        return new Workflow(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.board_id = source["board_id"];
+	        this.transitions = source["transitions"];
+	    }
+	}
 
 }
 
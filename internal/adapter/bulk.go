@@ -0,0 +1,39 @@
+package adapter
+
+import "kanban-app-playground/internal/application"
+
+// BulkItemResponse is the frontend-facing outcome for one card of a bulk operation.
+type BulkItemResponse struct {
+	ID    string         `json:"id"`
+	OK    bool           `json:"ok"`
+	Error *ErrorEnvelope `json:"error,omitempty"`
+}
+
+// BulkResponse is returned by the Bulk* Handler methods.
+//
+// What: Per-card outcomes with localized errors, plus the boards that changed.
+// Why: A bulk call succeeds as a whole even when some IDs fail; the UI reports those individually.
+// When: Built from an application.BulkResult after the transaction commits.
+type BulkResponse struct {
+	Items    []BulkItemResponse `json:"items"`
+	BoardIDs []string           `json:"board_ids"`
+}
+
+// bulkResponse converts a bulk result and emits a single change event for it.
+func (h *Handler) bulkResponse(res *application.BulkResult, err error) (*BulkResponse, error) {
+	if err != nil {
+		return nil, h.frontendError(err)
+	}
+	resp := &BulkResponse{Items: make([]BulkItemResponse, len(res.Items)), BoardIDs: res.BoardIDs}
+	for i, item := range res.Items {
+		resp.Items[i] = BulkItemResponse{ID: item.ID, OK: item.Err == nil}
+		if item.Err != nil {
+			env := h.envelope(item.Err)
+			resp.Items[i].Error = &env
+		}
+	}
+	if len(resp.BoardIDs) > 0 {
+		h.emit(EventCardsBulkChanged, resp)
+	}
+	return resp, nil
+}
@@ -0,0 +1,137 @@
+package adapter
+
+import (
+	"encoding/json"
+	"errors"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// Error codes carried in ErrorEnvelope.Code. They are a stable contract with the
+// frontend (see specs/001-kanban-board/contracts/wails-bindings.md): add new codes
+// freely, but never rename or reuse an existing one.
+const (
+	ErrCodeNotFound             = "not_found"
+	ErrCodeValidation           = "validation"
+	ErrCodeLastColumn           = "last_column"
+	ErrCodeInsufficientHistory  = "insufficient_history"
+	ErrCodeTransitionNotAllowed = "transition_not_allowed"
+	ErrCodeScriptFailed         = "script_failed"
+	ErrCodeInternal             = "internal"
+)
+
+// Supported message catalogue locales.
+const (
+	LocaleZhTW = "zh-TW"
+	LocaleEn   = "en"
+)
+
+// ErrorEnvelope is the JSON body of every error returned across the Wails boundary.
+//
+// What: A stable {code, message, details} triple instead of a free-form Go error string.
+// Why: Wails rejects the JS promise with err.Error(); without a code the frontend can only match on text.
+// When: Produced by frontendError for every non-nil error a Handler method returns.
+type ErrorEnvelope struct {
+	Code    string       `json:"code"`
+	Message string       `json:"message"`
+	Details ErrorDetails `json:"details"`
+}
+
+// ErrorDetails carries machine-readable context for an ErrorEnvelope.
+type ErrorDetails struct {
+	// Error is the original Go error text, useful for logs and bug reports.
+	Error string `json:"error"`
+	// Fields lists per-field problems when Code is "validation".
+	Fields []domain.FieldError `json:"fields,omitempty"`
+}
+
+// sentinelCodes maps domain sentinel errors to envelope codes; the first match wins.
+var sentinelCodes = []struct {
+	err  error
+	code string
+}{
+	{domain.ErrValidation, ErrCodeValidation},
+	{domain.ErrNotFound, ErrCodeNotFound},
+	{domain.ErrLastColumn, ErrCodeLastColumn},
+	{domain.ErrInsufficientHistory, ErrCodeInsufficientHistory},
+	{domain.ErrTransitionNotAllowed, ErrCodeTransitionNotAllowed},
+	{domain.ErrScriptFailed, ErrCodeScriptFailed},
+}
+
+// errorMessages is the localized message catalogue, keyed by locale then code.
+var errorMessages = map[string]map[string]string{
+	LocaleZhTW: {
+		ErrCodeNotFound:             "找不到指定的項目",
+		ErrCodeValidation:           "輸入的資料有誤",
+		ErrCodeLastColumn:           "看板至少需要保留一個欄位",
+		ErrCodeInsufficientHistory:  "已完成的卡片太少，無法預測",
+		ErrCodeTransitionNotAllowed: "此看板的流程不允許這樣移動卡片",
+		ErrCodeScriptFailed:         "腳本執行失敗",
+		ErrCodeInternal:             "發生未預期的錯誤",
+	},
+	LocaleEn: {
+		ErrCodeNotFound:             "The requested item was not found",
+		ErrCodeValidation:           "Some fields are invalid",
+		ErrCodeLastColumn:           "A board must keep at least one column",
+		ErrCodeInsufficientHistory:  "Not enough completed cards to forecast from",
+		ErrCodeTransitionNotAllowed: "This board's workflow does not allow that move",
+		ErrCodeScriptFailed:         "The script failed",
+		ErrCodeInternal:             "An unexpected error occurred",
+	},
+}
+
+// errorCode classifies err into an envelope code.
+func errorCode(err error) string {
+	for _, s := range sentinelCodes {
+		if errors.Is(err, s.err) {
+			return s.code
+		}
+	}
+	return ErrCodeInternal
+}
+
+// errorMessage looks up the catalogue message for code, falling back to zh-TW.
+func errorMessage(locale, code string) string {
+	if msgs, ok := errorMessages[locale]; ok {
+		if msg, ok := msgs[code]; ok {
+			return msg
+		}
+	}
+	return errorMessages[LocaleZhTW][code]
+}
+
+// currentLocale returns the locale selected with SetLocale.
+func (h *Handler) currentLocale() string {
+	h.localeMu.RLock()
+	defer h.localeMu.RUnlock()
+	return h.locale
+}
+
+// envelope builds the localized ErrorEnvelope for a non-nil error.
+func (h *Handler) envelope(err error) ErrorEnvelope {
+	code := errorCode(err)
+	env := ErrorEnvelope{
+		Code:    code,
+		Message: errorMessage(h.currentLocale(), code),
+		Details: ErrorDetails{Error: err.Error()},
+	}
+	var ve *domain.ValidationError
+	if errors.As(err, &ve) {
+		env.Details.Fields = ve.Fields
+	}
+	return env
+}
+
+// frontendError converts a service error into a JSON-encoded ErrorEnvelope, so the
+// string Wails hands to the frontend can be parsed back into a typed error.
+func (h *Handler) frontendError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	raw, mErr := json.Marshal(h.envelope(err))
+	if mErr != nil {
+		return err
+	}
+	return errors.New(string(raw))
+}
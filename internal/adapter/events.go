@@ -0,0 +1,95 @@
+package adapter
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// Events emitted to the frontend via the Wails runtime.
+const (
+	// EventExternalChange carries a domain.ExternalChange when another process writes to the database.
+	EventExternalChange = "boards:external-change"
+	// EventReminders carries a []domain.Reminder each time the scheduler fires.
+	EventReminders = "reminders:fired"
+	// EventRecurringCardsCreated carries the []domain.Card the recurrence scheduler just created.
+	EventRecurringCardsCreated = "recurrence:cards-created"
+	// EventCardsBulkChanged carries a BulkResponse once a bulk card operation commits.
+	EventCardsBulkChanged = "cards:bulk-changed"
+	// EventAutomationsRan carries the []domain.AutomationRun the due-date scheduler just fired.
+	EventAutomationsRan = "automations:ran"
+	// EventScriptHooksRan carries the board ID whose activity script hooks just handled,
+	// so the frontend can reload changes the hooks made.
+	EventScriptHooksRan = "scripts:hooks-ran"
+)
+
+// maxReminderNotifications is the most individual desktop notifications shown per scan;
+// larger batches are collapsed into a single summary.
+const maxReminderNotifications = 3
+
+// emit sends an event to the frontend. It is a no-op before Startup has run.
+func (h *Handler) emit(name string, data ...any) {
+	if h.ctx == nil {
+		return
+	}
+	runtime.EventsEmit(h.ctx, name, data...)
+}
+
+func (h *Handler) publishExternalChange(change domain.ExternalChange) {
+	if change.BoardIDs == nil {
+		change.BoardIDs = []string{}
+	}
+	h.emit(EventExternalChange, change)
+}
+
+func (h *Handler) publishRecurringCards(cards []domain.Card) {
+	h.emit(EventRecurringCardsCreated, cards)
+}
+
+func (h *Handler) publishAutomationRuns(runs []domain.AutomationRun) {
+	h.emit(EventAutomationsRan, runs)
+}
+
+func (h *Handler) publishScriptHooks(boardID string) {
+	h.emit(EventScriptHooksRan, boardID)
+}
+
+func (h *Handler) fireReminders(reminders []domain.Reminder) {
+	h.emit(EventReminders, reminders)
+
+	if len(reminders) > maxReminderNotifications {
+		msg := fmt.Sprintf("%d 張卡片即將到期或已逾期", len(reminders))
+		if err := h.notifier.Notify("看板提醒", msg); err != nil {
+			log.Printf("Warning: notify: %v", err)
+		}
+		return
+	}
+	for _, r := range reminders {
+		if err := h.notifier.Notify(reminderTitle(r), reminderMessage(r)); err != nil {
+			log.Printf("Warning: notify: %v", err)
+		}
+	}
+}
+
+func reminderTitle(r domain.Reminder) string {
+	switch r.Kind {
+	case domain.ReminderOverdue:
+		return "已逾期：" + r.CardTitle
+	case domain.ReminderSnoozed:
+		return "提醒：" + r.CardTitle
+	default:
+		return "即將到期：" + r.CardTitle
+	}
+}
+
+func reminderMessage(r domain.Reminder) string {
+	due := r.DueAt.Format(time.DateOnly)
+	if !r.AllDay {
+		due = r.DueAt.Local().Format("2006-01-02 15:04")
+	}
+	return fmt.Sprintf("%s · 到期 %s", r.BoardTitle, due)
+}
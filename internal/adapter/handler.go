@@ -2,6 +2,10 @@ package adapter
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
 
 	"kanban-app-playground/internal/application"
 	"kanban-app-playground/internal/domain"
@@ -10,43 +14,136 @@ import (
 // Handler is the Wails binding struct. All exported methods
 // are exposed to the frontend as TypeScript functions.
 type Handler struct {
-	ctx       context.Context
-	boardSvc  *application.BoardService
-	columnSvc *application.ColumnService
-	cardSvc   *application.CardService
+	ctx          context.Context
+	boardSvc     *application.BoardService
+	columnSvc    *application.ColumnService
+	cardSvc      *application.CardService
+	reminderSvc  *application.ReminderService
+	recurSvc     *application.RecurrenceService
+	analyticsSvc *application.AnalyticsService
+	personSvc    *application.PersonService
+	timeSvc      *application.TimeService
+	fieldSvc     *application.CustomFieldService
+	autoSvc      *application.AutomationService
+	scriptSvc    *application.ScriptService
+	watcher      domain.ChangeWatcher
+	notifier     domain.Notifier
+
+	// locale selects the error message catalogue (LocaleZhTW or LocaleEn). Wails
+	// runs bound methods concurrently, so it is guarded by localeMu.
+	localeMu sync.RWMutex
+	locale   string
+
+	// stop cancels the background workers started in Startup.
+	stop context.CancelFunc
 }
 
 func NewHandler(
 	boardSvc *application.BoardService,
 	columnSvc *application.ColumnService,
 	cardSvc *application.CardService,
+	reminderSvc *application.ReminderService,
+	recurSvc *application.RecurrenceService,
+	analyticsSvc *application.AnalyticsService,
+	personSvc *application.PersonService,
+	timeSvc *application.TimeService,
+	fieldSvc *application.CustomFieldService,
+	autoSvc *application.AutomationService,
+	scriptSvc *application.ScriptService,
+	watcher domain.ChangeWatcher,
+	notifier domain.Notifier,
 ) *Handler {
 	return &Handler{
-		boardSvc:  boardSvc,
-		columnSvc: columnSvc,
-		cardSvc:   cardSvc,
+		boardSvc:     boardSvc,
+		columnSvc:    columnSvc,
+		cardSvc:      cardSvc,
+		reminderSvc:  reminderSvc,
+		recurSvc:     recurSvc,
+		analyticsSvc: analyticsSvc,
+		personSvc:    personSvc,
+		timeSvc:      timeSvc,
+		fieldSvc:     fieldSvc,
+		autoSvc:      autoSvc,
+		scriptSvc:    scriptSvc,
+		watcher:      watcher,
+		notifier:     notifier,
+		locale:       LocaleZhTW,
 	}
 }
 
 // Startup is called by Wails when the app starts.
 func (h *Handler) Startup(ctx context.Context) {
 	h.ctx = ctx
+
+	bg, cancel := context.WithCancel(ctx)
+	h.stop = cancel
+
+	h.runWorker("change watcher", func() error {
+		return h.watcher.Watch(bg, h.publishExternalChange)
+	})
+	h.runWorker("reminder scheduler", func() error {
+		return h.reminderSvc.Run(bg, h.fireReminders)
+	})
+	h.runWorker("recurrence scheduler", func() error {
+		return h.recurSvc.Run(bg, h.publishRecurringCards)
+	})
+	h.runWorker("automation scheduler", func() error {
+		return h.autoSvc.Run(bg, h.publishAutomationRuns)
+	})
+	h.runWorker("script hooks", func() error {
+		return h.scriptSvc.Run(bg, h.publishScriptHooks)
+	})
+}
+
+// runWorker runs fn in the background, logging why it stopped unless it was cancelled.
+func (h *Handler) runWorker(name string, fn func() error) {
+	go func() {
+		if err := fn(); err != nil && !errors.Is(err, context.Canceled) {
+			log.Printf("Warning: %s stopped: %v", name, err)
+		}
+	}()
 }
 
 // Shutdown is called by Wails when the app is closing.
-func (h *Handler) Shutdown(_ context.Context) {}
+func (h *Handler) Shutdown(_ context.Context) {
+	if h.stop != nil {
+		h.stop()
+	}
+}
 
 // SeedIfEmpty delegates to BoardService to populate sample data on first launch.
 func (h *Handler) SeedIfEmpty(ctx context.Context) error {
 	return h.boardSvc.SeedIfEmpty(ctx)
 }
 
+// ─── Errors ─────────────────────────────────────────────────
+
+// SetLocale selects the language of ErrorEnvelope messages ("zh-TW" or "en").
+func (h *Handler) SetLocale(locale string) error {
+	if _, ok := errorMessages[locale]; !ok {
+		return h.frontendError(fmt.Errorf("%w: unsupported locale %q", domain.ErrValidation, locale))
+	}
+	h.localeMu.Lock()
+	h.locale = locale
+	h.localeMu.Unlock()
+	return nil
+}
+
+// GetErrorMessages returns the message catalogue for locale, keyed by error code,
+// so the frontend can localize envelopes itself.
+func (h *Handler) GetErrorMessages(locale string) map[string]string {
+	if msgs, ok := errorMessages[locale]; ok {
+		return msgs
+	}
+	return errorMessages[LocaleZhTW]
+}
+
 // ─── Board ──────────────────────────────────────────────────
 
 func (h *Handler) GetAllBoards() ([]domain.Board, error) {
 	boards, err := h.boardSvc.GetAll(h.ctx)
 	if err != nil {
-		return nil, err
+		return nil, h.frontendError(err)
 	}
 	if boards == nil {
 		boards = []domain.Board{}
@@ -55,63 +152,506 @@ func (h *Handler) GetAllBoards() ([]domain.Board, error) {
 }
 
 func (h *Handler) CreateBoard(title string) (*domain.Board, error) {
-	return h.boardSvc.Create(h.ctx, title)
+	board, err := h.boardSvc.Create(h.ctx, title)
+	return board, h.frontendError(err)
 }
 
 func (h *Handler) UpdateBoard(id, title string) (*domain.Board, error) {
-	return h.boardSvc.Update(h.ctx, id, title)
+	board, err := h.boardSvc.Update(h.ctx, id, title)
+	return board, h.frontendError(err)
 }
 
 func (h *Handler) DeleteBoard(id string) error {
-	return h.boardSvc.Delete(h.ctx, id)
+	return h.frontendError(h.boardSvc.Delete(h.ctx, id))
 }
 
 func (h *Handler) GetBoardWithData(boardID string) (*application.BoardData, error) {
-	return h.boardSvc.GetWithData(h.ctx, boardID)
+	data, err := h.boardSvc.GetWithData(h.ctx, boardID)
+	return data, h.frontendError(err)
+}
+
+func (h *Handler) DuplicateBoard(boardID, newTitle string, includeCards bool) (*domain.Board, error) {
+	board, err := h.boardSvc.Duplicate(h.ctx, boardID, newTitle, includeCards)
+	return board, h.frontendError(err)
+}
+
+// SetBoardFlowColumns marks the columns where work starts and ends for flow metrics.
+// Pass an empty ID to use the default (first active and first done column).
+func (h *Handler) SetBoardFlowColumns(boardID, startColumnID, doneColumnID string) (*domain.Board, error) {
+	board, err := h.boardSvc.SetFlowColumns(h.ctx, boardID, startColumnID, doneColumnID)
+	return board, h.frontendError(err)
+}
+
+// GetEstimateScales lists the built-in estimate scales a board can use.
+func (h *Handler) GetEstimateScales() []domain.EstimateScale {
+	scales := make([]domain.EstimateScale, 0, len(domain.EstimateScales))
+	for _, kind := range []string{domain.EstimateScaleFibonacci, domain.EstimateScaleTShirt, domain.EstimateScaleHours} {
+		scales = append(scales, domain.EstimateScales[kind])
+	}
+	return scales
+}
+
+// SetBoardEstimateScale switches a board to the fibonacci, tshirt or hours scale.
+func (h *Handler) SetBoardEstimateScale(boardID, kind string) (*domain.Board, error) {
+	board, err := h.boardSvc.SetEstimateScale(h.ctx, boardID, kind)
+	return board, h.frontendError(err)
+}
+
+// SetBoardPriorities replaces a board's priority levels. Cards on a removed level
+// move to the remaining level nearest in weight.
+func (h *Handler) SetBoardPriorities(boardID string, levels []domain.PriorityLevel) (*domain.Board, error) {
+	board, err := h.boardSvc.SetPriorities(h.ctx, boardID, levels)
+	return board, h.frontendError(err)
+}
+
+// ─── Workflow ───────────────────────────────────────────────
+
+// GetWorkflow returns a board's transition rules: for each restricted column,
+// the columns its cards may move to.
+func (h *Handler) GetWorkflow(boardID string) (*domain.Workflow, error) {
+	w, err := h.boardSvc.Workflow(h.ctx, boardID)
+	return w, h.frontendError(err)
+}
+
+// SetWorkflow replaces a board's transition rules; columns left out are unrestricted.
+func (h *Handler) SetWorkflow(boardID string, transitions map[string][]string) (*domain.Workflow, error) {
+	w, err := h.boardSvc.SetWorkflow(h.ctx, boardID, transitions)
+	return w, h.frontendError(err)
+}
+
+// ─── Automations ────────────────────────────────────────────
+
+// GetAutomations returns a board's automation rules, oldest first.
+func (h *Handler) GetAutomations(boardID string) ([]domain.AutomationRule, error) {
+	rules, err := h.autoSvc.List(h.ctx, boardID)
+	if err != nil {
+		return nil, h.frontendError(err)
+	}
+	if rules == nil {
+		rules = []domain.AutomationRule{}
+	}
+	return rules, nil
+}
+
+func (h *Handler) CreateAutomation(boardID string, in domain.AutomationRuleInput) (*domain.AutomationRule, error) {
+	rule, err := h.autoSvc.Create(h.ctx, boardID, in)
+	return rule, h.frontendError(err)
+}
+
+func (h *Handler) UpdateAutomation(id string, in domain.AutomationRuleInput) (*domain.AutomationRule, error) {
+	rule, err := h.autoSvc.Update(h.ctx, id, in)
+	return rule, h.frontendError(err)
+}
+
+func (h *Handler) DeleteAutomation(id string) error {
+	return h.frontendError(h.autoSvc.Delete(h.ctx, id))
+}
+
+// GetAutomationRuns returns a board's automation execution log, newest first.
+// A limit of zero or less returns every kept entry.
+func (h *Handler) GetAutomationRuns(boardID string, limit int) ([]domain.AutomationRun, error) {
+	runs, err := h.autoSvc.Runs(h.ctx, boardID, limit)
+	if err != nil {
+		return nil, h.frontendError(err)
+	}
+	if runs == nil {
+		runs = []domain.AutomationRun{}
+	}
+	return runs, nil
+}
+
+// ─── Scripts ────────────────────────────────────────────────
+
+// GetScripts lists the Starlark scripts in the scripts folder under the config
+// directory, with their functions and any load or hook errors.
+func (h *Handler) GetScripts() ([]domain.Script, error) {
+	scripts, err := h.scriptSvc.List(h.ctx)
+	if err != nil {
+		return nil, h.frontendError(err)
+	}
+	if scripts == nil {
+		scripts = []domain.Script{}
+	}
+	return scripts, nil
+}
+
+// GetScriptCommands returns the script commands to offer in the command palette.
+func (h *Handler) GetScriptCommands() ([]domain.ScriptCommand, error) {
+	cmds, err := h.scriptSvc.Commands(h.ctx)
+	if err != nil {
+		return nil, h.frontendError(err)
+	}
+	if cmds == nil {
+		cmds = []domain.ScriptCommand{}
+	}
+	return cmds, nil
+}
+
+// RunScriptCommand runs a palette command with the currently selected board and
+// card (either may be empty) and returns its result and printed output.
+func (h *Handler) RunScriptCommand(script, function, boardID, cardID string) (*domain.ScriptResult, error) {
+	res, err := h.scriptSvc.RunCommand(h.ctx, script, function, boardID, cardID)
+	return res, h.frontendError(err)
+}
+
+// ─── Board Templates ────────────────────────────────────────
+
+func (h *Handler) ListBoardTemplates() ([]domain.BoardTemplate, error) {
+	tpls, err := h.boardSvc.ListTemplates(h.ctx)
+	return tpls, h.frontendError(err)
+}
+
+func (h *Handler) SaveBoardAsTemplate(boardID, name string, includeCards bool) (*domain.BoardTemplate, error) {
+	tpl, err := h.boardSvc.SaveAsTemplate(h.ctx, boardID, name, includeCards)
+	return tpl, h.frontendError(err)
+}
+
+func (h *Handler) CreateBoardFromTemplate(templateID, title string) (*domain.Board, error) {
+	board, err := h.boardSvc.CreateFromTemplate(h.ctx, templateID, title)
+	return board, h.frontendError(err)
+}
+
+func (h *Handler) DeleteBoardTemplate(id string) error {
+	return h.frontendError(h.boardSvc.DeleteTemplate(h.ctx, id))
+}
+
+// ─── Custom Fields ──────────────────────────────────────────
+
+func (h *Handler) GetCustomFields(boardID string) ([]domain.CustomField, error) {
+	fields, err := h.fieldSvc.List(h.ctx, boardID)
+	if err != nil {
+		return nil, h.frontendError(err)
+	}
+	if fields == nil {
+		fields = []domain.CustomField{}
+	}
+	return fields, nil
+}
+
+// CreateCustomField adds a field to a board. type is one of text, number, date,
+// select, multi_select, checkbox or url; select types need options.
+func (h *Handler) CreateCustomField(boardID string, input domain.CustomFieldInput) (*domain.CustomField, error) {
+	f, err := h.fieldSvc.Create(h.ctx, boardID, input)
+	return f, h.frontendError(err)
+}
+
+// UpdateCustomField renames a field or edits its options; the type cannot change.
+func (h *Handler) UpdateCustomField(id string, input domain.CustomFieldInput) (*domain.CustomField, error) {
+	f, err := h.fieldSvc.Update(h.ctx, id, input)
+	return f, h.frontendError(err)
+}
+
+func (h *Handler) DeleteCustomField(id string) error {
+	return h.frontendError(h.fieldSvc.Delete(h.ctx, id))
+}
+
+// SetCardCustomField sets a card's value for a field; null or an empty value clears it.
+func (h *Handler) SetCardCustomField(cardID, fieldID string, value any) (*domain.Card, error) {
+	card, err := h.fieldSvc.SetValue(h.ctx, cardID, fieldID, value)
+	return card, h.frontendError(err)
 }
 
 // ─── Column ─────────────────────────────────────────────────
 
 func (h *Handler) CreateColumn(boardID, title string) (*domain.Column, error) {
-	return h.columnSvc.Create(h.ctx, boardID, title)
+	col, err := h.columnSvc.Create(h.ctx, boardID, title)
+	return col, h.frontendError(err)
 }
 
 func (h *Handler) UpdateColumn(id, title string) (*domain.Column, error) {
-	return h.columnSvc.Update(h.ctx, id, title)
+	col, err := h.columnSvc.Update(h.ctx, id, title)
+	return col, h.frontendError(err)
+}
+
+// SetColumnKind marks a column as backlog, active or done; cards entering a done
+// column are stamped as completed.
+func (h *Handler) SetColumnKind(id, kind string) (*domain.Column, error) {
+	col, err := h.columnSvc.SetKind(h.ctx, id, kind)
+	return col, h.frontendError(err)
 }
 
 func (h *Handler) DeleteColumn(id string, moveCardsTo string) error {
-	return h.columnSvc.Delete(h.ctx, id, moveCardsTo)
+	return h.frontendError(h.columnSvc.Delete(h.ctx, id, moveCardsTo))
 }
 
 func (h *Handler) MoveColumn(id string, newPosition int) error {
-	return h.columnSvc.Move(h.ctx, id, newPosition)
+	return h.frontendError(h.columnSvc.Move(h.ctx, id, newPosition))
+}
+
+func (h *Handler) DuplicateColumn(id string) (*domain.Column, error) {
+	col, err := h.columnSvc.Duplicate(h.ctx, id)
+	return col, h.frontendError(err)
 }
 
 // ─── Card ───────────────────────────────────────────────────
 
 func (h *Handler) CreateCard(columnID, title string) (*domain.Card, error) {
-	return h.cardSvc.Create(h.ctx, columnID, title)
+	card, err := h.cardSvc.Create(h.ctx, columnID, title)
+	return card, h.frontendError(err)
+}
+
+// QuickAddCard creates a card from text with inline tokens such as !high, #label,
+// @person, due:friday and ~3, and reports how each token was resolved.
+func (h *Handler) QuickAddCard(columnID, text string) (*domain.QuickAddResult, error) {
+	res, err := h.cardSvc.QuickAdd(h.ctx, columnID, text)
+	return res, h.frontendError(err)
 }
 
 func (h *Handler) UpdateCard(id string, updates domain.CardUpdate) (*domain.Card, error) {
-	return h.cardSvc.Update(h.ctx, id, updates)
+	card, err := h.cardSvc.Update(h.ctx, id, updates)
+	return card, h.frontendError(err)
 }
 
 func (h *Handler) DeleteCard(id string) error {
-	return h.cardSvc.Delete(h.ctx, id)
+	return h.frontendError(h.cardSvc.Delete(h.ctx, id))
 }
 
+// MoveCard moves a card within its board, following the board's workflow.
 func (h *Handler) MoveCard(id, targetColumnID string, newPosition int) error {
-	return h.cardSvc.Move(h.ctx, id, targetColumnID, newPosition)
+	return h.frontendError(h.cardSvc.Move(h.ctx, id, targetColumnID, newPosition, false))
+}
+
+// ForceMoveCard is the admin override of MoveCard: it ignores the board's workflow.
+func (h *Handler) ForceMoveCard(id, targetColumnID string, newPosition int) error {
+	return h.frontendError(h.cardSvc.Move(h.ctx, id, targetColumnID, newPosition, true))
+}
+
+func (h *Handler) MoveCardToBoard(cardID, targetBoardID, targetColumnID string) (*domain.Card, error) {
+	card, err := h.cardSvc.MoveToBoard(h.ctx, cardID, targetBoardID, targetColumnID)
+	return card, h.frontendError(err)
+}
+
+func (h *Handler) GetCardHistory(cardID string) ([]domain.CardEvent, error) {
+	events, err := h.cardSvc.History(h.ctx, cardID)
+	if err != nil {
+		return nil, h.frontendError(err)
+	}
+	if events == nil {
+		events = []domain.CardEvent{}
+	}
+	return events, nil
+}
+
+func (h *Handler) DuplicateCard(cardID, targetColumnID string) (*domain.Card, error) {
+	card, err := h.cardSvc.Duplicate(h.ctx, cardID, targetColumnID)
+	return card, h.frontendError(err)
+}
+
+func (h *Handler) BulkUpdateCards(ids []string, updates domain.CardUpdate) (*BulkResponse, error) {
+	return h.bulkResponse(h.cardSvc.BulkUpdate(h.ctx, ids, updates))
+}
+
+func (h *Handler) BulkMoveCards(ids []string, columnID string) (*BulkResponse, error) {
+	return h.bulkResponse(h.cardSvc.BulkMove(h.ctx, ids, columnID))
+}
+
+func (h *Handler) BulkDeleteCards(ids []string) (*BulkResponse, error) {
+	return h.bulkResponse(h.cardSvc.BulkDelete(h.ctx, ids))
+}
+
+func (h *Handler) BulkArchive(ids []string) (*BulkResponse, error) {
+	return h.bulkResponse(h.cardSvc.BulkArchive(h.ctx, ids))
+}
+
+// UndoBulk reverses the most recent bulk card operation.
+func (h *Handler) UndoBulk() (*BulkResponse, error) {
+	return h.bulkResponse(h.cardSvc.Undo(h.ctx))
+}
+
+func (h *Handler) SetCardAssignees(cardID string, personIDs []string) (*domain.Card, error) {
+	card, err := h.cardSvc.SetAssignees(h.ctx, cardID, personIDs)
+	return card, h.frontendError(err)
+}
+
+// ─── Card Templates ─────────────────────────────────────────
+
+func (h *Handler) ListCardTemplates(boardID string) ([]domain.CardTemplate, error) {
+	tpls, err := h.cardSvc.ListTemplates(h.ctx, boardID)
+	if err != nil {
+		return nil, h.frontendError(err)
+	}
+	if tpls == nil {
+		tpls = []domain.CardTemplate{}
+	}
+	return tpls, nil
+}
+
+func (h *Handler) CreateCardTemplate(boardID string, input domain.CardTemplateInput) (*domain.CardTemplate, error) {
+	tpl, err := h.cardSvc.CreateTemplate(h.ctx, boardID, input)
+	return tpl, h.frontendError(err)
+}
+
+func (h *Handler) UpdateCardTemplate(id string, input domain.CardTemplateInput) (*domain.CardTemplate, error) {
+	tpl, err := h.cardSvc.UpdateTemplate(h.ctx, id, input)
+	return tpl, h.frontendError(err)
+}
+
+func (h *Handler) DeleteCardTemplate(id string) error {
+	return h.frontendError(h.cardSvc.DeleteTemplate(h.ctx, id))
+}
+
+func (h *Handler) CreateCardFromTemplate(columnID, templateID string, vars map[string]string) (*domain.Card, error) {
+	card, err := h.cardSvc.CreateFromTemplate(h.ctx, columnID, templateID, vars)
+	return card, h.frontendError(err)
+}
+
+// ─── Recurrences ────────────────────────────────────────────
+
+func (h *Handler) ListRecurrences(boardID string) ([]domain.Recurrence, error) {
+	recs, err := h.recurSvc.GetByBoard(h.ctx, boardID)
+	if err != nil {
+		return nil, h.frontendError(err)
+	}
+	if recs == nil {
+		recs = []domain.Recurrence{}
+	}
+	return recs, nil
+}
+
+func (h *Handler) CreateRecurrence(input domain.RecurrenceInput) (*domain.Recurrence, error) {
+	rec, err := h.recurSvc.Create(h.ctx, input)
+	return rec, h.frontendError(err)
+}
+
+func (h *Handler) DeleteRecurrence(id string) error {
+	return h.frontendError(h.recurSvc.Delete(h.ctx, id))
+}
+
+// ─── Reminders ──────────────────────────────────────────────
+
+func (h *Handler) GetReminderSettings() (domain.ReminderSettings, error) {
+	saved, err := h.reminderSvc.GetSettings(h.ctx)
+	return saved, h.frontendError(err)
+}
+
+func (h *Handler) UpdateReminderSettings(settings domain.ReminderSettings) (domain.ReminderSettings, error) {
+	saved, err := h.reminderSvc.UpdateSettings(h.ctx, settings)
+	return saved, h.frontendError(err)
+}
+
+func (h *Handler) SnoozeReminder(cardID string, minutes int) error {
+	return h.frontendError(h.reminderSvc.Snooze(h.ctx, cardID, minutes))
+}
+
+// ─── People ─────────────────────────────────────────────────
+
+func (h *Handler) GetPeople() ([]domain.Person, error) {
+	people, err := h.personSvc.List(h.ctx)
+	if err != nil {
+		return nil, h.frontendError(err)
+	}
+	if people == nil {
+		people = []domain.Person{}
+	}
+	return people, nil
+}
+
+func (h *Handler) CreatePerson(input domain.PersonInput) (*domain.Person, error) {
+	p, err := h.personSvc.Create(h.ctx, input)
+	return p, h.frontendError(err)
+}
+
+func (h *Handler) UpdatePerson(id string, input domain.PersonInput) (*domain.Person, error) {
+	p, err := h.personSvc.Update(h.ctx, id, input)
+	return p, h.frontendError(err)
+}
+
+func (h *Handler) DeletePerson(id string) error {
+	return h.frontendError(h.personSvc.Delete(h.ctx, id))
+}
+
+// ─── Time Tracking ──────────────────────────────────────────
+
+// StartTimer starts a timer on a card, stopping any timer already running.
+func (h *Handler) StartTimer(cardID, note string) (*domain.TimeEntry, error) {
+	entry, err := h.timeSvc.StartTimer(h.ctx, cardID, note)
+	return entry, h.frontendError(err)
+}
+
+// StopTimer stops the running timer and returns the finished entry.
+func (h *Handler) StopTimer() (*domain.TimeEntry, error) {
+	entry, err := h.timeSvc.StopTimer(h.ctx)
+	return entry, h.frontendError(err)
+}
+
+// GetRunningTimer returns the running timer, or null when none is running.
+func (h *Handler) GetRunningTimer() (*domain.TimeEntry, error) {
+	entry, err := h.timeSvc.RunningTimer(h.ctx)
+	return entry, h.frontendError(err)
+}
+
+// AddTimeEntry records time worked on a card without a timer.
+func (h *Handler) AddTimeEntry(cardID string, input domain.TimeEntryInput) (*domain.TimeEntry, error) {
+	entry, err := h.timeSvc.AddEntry(h.ctx, cardID, input)
+	return entry, h.frontendError(err)
+}
+
+func (h *Handler) DeleteTimeEntry(id string) error {
+	return h.frontendError(h.timeSvc.DeleteEntry(h.ctx, id))
+}
+
+// GetCardTime lists a card's time entries with their total.
+func (h *Handler) GetCardTime(cardID string) (*domain.CardTimeLog, error) {
+	log, err := h.timeSvc.CardLog(h.ctx, cardID)
+	return log, h.frontendError(err)
+}
+
+// GetTimeReport totals a board's logged time per card and per day between from
+// and to (YYYY-MM-DD, inclusive; empty for an open range).
+func (h *Handler) GetTimeReport(boardID, from, to string) (*domain.TimeReport, error) {
+	report, err := h.timeSvc.Report(h.ctx, boardID, from, to)
+	return report, h.frontendError(err)
+}
+
+// ExportTimesheetCSV returns the same entries as GetTimeReport as CSV text.
+func (h *Handler) ExportTimesheetCSV(boardID, from, to string) (string, error) {
+	csv, err := h.timeSvc.ExportCSV(h.ctx, boardID, from, to)
+	return csv, h.frontendError(err)
+}
+
+// ─── Analytics ──────────────────────────────────────────────
+
+// GetBoardStats returns card counts, due-date health and recent activity for a board.
+func (h *Handler) GetBoardStats(boardID string) (*domain.BoardStats, error) {
+	stats, err := h.analyticsSvc.BoardStats(h.ctx, boardID)
+	return stats, h.frontendError(err)
+}
+
+// GetCumulativeFlow returns per-column card counts for each day or week
+// ("day" or "week") between from and to, both YYYY-MM-DD.
+func (h *Handler) GetCumulativeFlow(boardID, from, to, interval string) (*domain.CumulativeFlow, error) {
+	flow, err := h.analyticsSvc.CumulativeFlow(h.ctx, boardID, from, to, interval)
+	return flow, h.frontendError(err)
+}
+
+// GetFlowMetrics returns lead and cycle times, throughput and aging WIP for a board.
+func (h *Handler) GetFlowMetrics(boardID string, filter domain.FlowMetricsFilter) (*domain.FlowMetrics, error) {
+	metrics, err := h.analyticsSvc.FlowMetrics(h.ctx, boardID, filter)
+	return metrics, h.frontendError(err)
+}
+
+// ForecastCompletion simulates when the remaining work will be done. unit is "cards"
+// (the default when empty) or "points" to forecast on summed estimates. A zero seed
+// picks a random one; the seed used is returned so the run can be reproduced.
+func (h *Handler) ForecastCompletion(boardID, unit string, remaining float64, simulations int, seed int64) (*domain.CompletionForecast, error) {
+	forecast, err := h.analyticsSvc.ForecastCompletion(h.ctx, boardID, unit, remaining, simulations, seed)
+	return forecast, h.frontendError(err)
+}
+
+// ForecastCardsByDate simulates how many cards (or points, per unit) will be done by date (YYYY-MM-DD).
+func (h *Handler) ForecastCardsByDate(boardID, unit, date string, simulations int, seed int64) (*domain.CardsForecast, error) {
+	forecast, err := h.analyticsSvc.ForecastCardsByDate(h.ctx, boardID, unit, date, simulations, seed)
+	return forecast, h.frontendError(err)
 }
 
 // ─── Search ─────────────────────────────────────────────────
 
-func (h *Handler) SearchCards(boardID, query string) ([]domain.Card, error) {
-	cards, err := h.cardSvc.Search(h.ctx, boardID, query)
+// SearchCards matches title and description. assigneeID may be empty (anyone),
+// a person ID, or "none" for unassigned cards.
+func (h *Handler) SearchCards(boardID, query, assigneeID string) ([]domain.Card, error) {
+	cards, err := h.cardSvc.Search(h.ctx, boardID, query, assigneeID)
 	if err != nil {
-		return nil, err
+		return nil, h.frontendError(err)
 	}
 	if cards == nil {
 		cards = []domain.Card{}
@@ -119,6 +659,10 @@ func (h *Handler) SearchCards(boardID, query string) ([]domain.Card, error) {
 	return cards, nil
 }
 
-func (h *Handler) FilterCards(boardID, priority string) (*application.BoardData, error) {
-	return h.boardSvc.FilterCards(h.ctx, boardID, priority)
+// FilterCards filters by priority, assignee and custom field values; empty values
+// match everything, a priority such as "high+" matches that level and above, and
+// assigneeID "none" matches unassigned cards.
+func (h *Handler) FilterCards(boardID, priority, assigneeID string, fieldFilters []domain.FieldFilter) (*application.BoardData, error) {
+	data, err := h.boardSvc.FilterCards(h.ctx, boardID, priority, assigneeID, fieldFilters)
+	return data, h.frontendError(err)
 }
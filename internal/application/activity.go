@@ -0,0 +1,75 @@
+package application
+
+import (
+	"context"
+	"sync"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// ActivityFeed fans card and board activity out to subscribers.
+//
+// What: An in-process publisher of domain.CardActivity and domain.BoardActivity.
+// Why: Features such as automations and script hooks react to changes without
+// CardService or BoardService knowing about them.
+// When: The services publish after each change has been saved, outside their
+// transactions. Subscribers run synchronously, in subscription order, on the caller's
+// goroutine, so their own changes are in place when the original call returns.
+type ActivityFeed struct {
+	mu        sync.RWMutex
+	cardSubs  []func(context.Context, domain.CardActivity)
+	boardSubs []func(context.Context, domain.BoardActivity)
+}
+
+func NewActivityFeed() *ActivityFeed {
+	return &ActivityFeed{}
+}
+
+// SubscribeCards registers fn to receive every published card activity.
+func (f *ActivityFeed) SubscribeCards(fn func(context.Context, domain.CardActivity)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.cardSubs = append(f.cardSubs, fn)
+}
+
+// SubscribeBoards registers fn to receive every published board activity.
+func (f *ActivityFeed) SubscribeBoards(fn func(context.Context, domain.BoardActivity)) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.boardSubs = append(f.boardSubs, fn)
+}
+
+func (f *ActivityFeed) publish(ctx context.Context, a domain.CardActivity) {
+	f.mu.RLock()
+	subs := f.cardSubs
+	f.mu.RUnlock()
+	for _, fn := range subs {
+		fn(ctx, a)
+	}
+}
+
+func (f *ActivityFeed) publishBoard(ctx context.Context, kind, boardID string) {
+	f.mu.RLock()
+	subs := f.boardSubs
+	f.mu.RUnlock()
+	for _, fn := range subs {
+		fn(ctx, domain.BoardActivity{Kind: kind, BoardID: boardID})
+	}
+}
+
+// publishCreated announces a card created in col.
+func (f *ActivityFeed) publishCreated(ctx context.Context, cardID string, col *domain.Column) {
+	f.publish(ctx, domain.CardActivity{
+		Kind: domain.TriggerCardCreated, CardID: cardID, BoardID: col.BoardID, ColumnID: col.ID,
+	})
+}
+
+// publishMoved announces a card moved between columns; same-column reorders are not announced.
+func (f *ActivityFeed) publishMoved(ctx context.Context, cardID string, from, to *domain.Column) {
+	if from.ID == to.ID {
+		return
+	}
+	f.publish(ctx, domain.CardActivity{
+		Kind: domain.TriggerCardMoved, CardID: cardID, BoardID: to.BoardID, ColumnID: to.ID, FromColumnID: from.ID,
+	})
+}
@@ -0,0 +1,214 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// AnalyticsService answers reporting queries over boards and card history.
+type AnalyticsService struct {
+	boards  domain.BoardRepository
+	columns domain.ColumnRepository
+	cards   domain.CardRepository
+	history domain.HistoryRepository
+	stats   domain.StatsRepository
+	fields  domain.CustomFieldRepository
+	now     func() time.Time
+	loc     *time.Location
+}
+
+func NewAnalyticsService(
+	boards domain.BoardRepository,
+	columns domain.ColumnRepository,
+	cards domain.CardRepository,
+	history domain.HistoryRepository,
+	stats domain.StatsRepository,
+	fields domain.CustomFieldRepository,
+) *AnalyticsService {
+	return &AnalyticsService{
+		boards:  boards,
+		columns: columns,
+		cards:   cards,
+		history: history,
+		stats:   stats,
+		fields:  fields,
+		now:     time.Now,
+		loc:     time.Local,
+	}
+}
+
+// dateRange is a validated reporting range split into buckets. from is the first
+// instant requested, starts[i] is the first instant of bucket i (the first bucket may
+// begin before from) and end is the instant after the last bucket.
+type dateRange struct {
+	from   time.Time
+	starts []time.Time
+	end    time.Time
+}
+
+// bucketEnd returns the instant after bucket i.
+func (r dateRange) bucketEnd(i int) time.Time {
+	if i+1 < len(r.starts) {
+		return r.starts[i+1]
+	}
+	return r.end
+}
+
+// parseRange validates a YYYY-MM-DD range (inclusive) and splits it into day or
+// week buckets in the service's timezone. Weekly buckets start on Monday.
+// The range is clipped to today.
+func (s *AnalyticsService) parseRange(v *domain.Validator, from, to, interval string) dateRange {
+	if interval != domain.IntervalDay && interval != domain.IntervalWeek {
+		v.Add("interval", domain.CodeInvalidValue, "interval must be day or week")
+	}
+	start, errFrom := time.ParseInLocation(time.DateOnly, from, s.loc)
+	if errFrom != nil {
+		v.Add("from", domain.CodeInvalidFormat, "from must be YYYY-MM-DD")
+	}
+	last, errTo := time.ParseInLocation(time.DateOnly, to, s.loc)
+	if errTo != nil {
+		v.Add("to", domain.CodeInvalidFormat, "to must be YYYY-MM-DD")
+	}
+	if errFrom != nil || errTo != nil {
+		return dateRange{}
+	}
+	if last.Before(start) {
+		v.Add("to", domain.CodeOutOfOrder, "to must not be before from")
+		return dateRange{}
+	}
+
+	now := s.now().In(s.loc)
+	if today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, s.loc); last.After(today) {
+		last = today
+	}
+	r := dateRange{from: start}
+	step := 1
+	if interval == domain.IntervalWeek {
+		step = 7
+		start = start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+	}
+
+	r.end = last.AddDate(0, 0, 1)
+	for t := start; t.Before(r.end); t = t.AddDate(0, 0, step) {
+		if len(r.starts) == domain.MaxSeriesPoints {
+			v.Add("to", domain.CodeInvalidValue, fmt.Sprintf("range produces more than %d points", domain.MaxSeriesPoints))
+			return dateRange{}
+		}
+		r.starts = append(r.starts, t)
+	}
+	return r
+}
+
+// CumulativeFlow replays boardID's card history and counts the cards in each column
+// at the end of every day or week between from and to (inclusive, YYYY-MM-DD).
+// Columns are tracked by ID, so renames keep their band; deleted columns keep the
+// last title history recorded for them.
+func (s *AnalyticsService) CumulativeFlow(ctx context.Context, boardID, from, to, interval string) (*domain.CumulativeFlow, error) {
+	if interval == "" {
+		interval = domain.IntervalDay
+	}
+	v := domain.NewValidator()
+	rng := s.parseRange(v, from, to, interval)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.boards.GetByID(ctx, boardID); err != nil {
+		return nil, err
+	}
+	cols, err := s.columns.GetByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.history.ListByBoard(ctx, boardID, rng.end)
+	if err != nil {
+		return nil, err
+	}
+
+	flow := &domain.CumulativeFlow{BoardID: boardID, Interval: interval, Points: []domain.FlowPoint{}}
+	index := make(map[string]int, len(cols))
+	for _, c := range cols {
+		index[c.ID] = len(flow.Columns)
+		flow.Columns = append(flow.Columns, domain.FlowColumn{ID: c.ID, Title: c.Title})
+	}
+	// Deleted columns are appended as history reveals them.
+	deletedTitles := make(map[string]string)
+	for _, e := range events {
+		if e.ToColumnID == "" || e.BoardID != boardID {
+			continue
+		}
+		if _, ok := index[e.ToColumnID]; ok {
+			continue
+		}
+		if _, seen := deletedTitles[e.ToColumnID]; !seen {
+			index[e.ToColumnID] = len(flow.Columns)
+			flow.Columns = append(flow.Columns, domain.FlowColumn{ID: e.ToColumnID, Deleted: true})
+		}
+		deletedTitles[e.ToColumnID] = e.ToColumnTitle
+	}
+	for i := range flow.Columns {
+		if flow.Columns[i].Deleted {
+			flow.Columns[i].Title = deletedTitles[flow.Columns[i].ID]
+		}
+	}
+
+	location := make(map[string]string) // card ID → column ID on this board
+	counts := make([]int, len(flow.Columns))
+	leave := func(cardID string) {
+		if col, ok := location[cardID]; ok {
+			counts[index[col]]--
+			delete(location, cardID)
+		}
+	}
+
+	next := 0
+	for i, start := range rng.starts {
+		end := rng.bucketEnd(i)
+		for ; next < len(events) && events[next].At.Before(end); next++ {
+			e := events[next]
+			leave(e.CardID)
+			if e.ToColumnID != "" && e.BoardID == boardID {
+				location[e.CardID] = e.ToColumnID
+				counts[index[e.ToColumnID]]++
+			}
+		}
+		flow.Points = append(flow.Points, domain.FlowPoint{
+			Date:   start.Format(time.DateOnly),
+			Counts: append([]int(nil), counts...),
+		})
+	}
+
+	return dropEmptyDeletedColumns(flow), nil
+}
+
+// dropEmptyDeletedColumns removes deleted columns that held no cards in any point.
+func dropEmptyDeletedColumns(flow *domain.CumulativeFlow) *domain.CumulativeFlow {
+	keep := make([]bool, len(flow.Columns))
+	for i, c := range flow.Columns {
+		keep[i] = !c.Deleted
+		for _, p := range flow.Points {
+			keep[i] = keep[i] || p.Counts[i] > 0
+		}
+	}
+
+	columns := []domain.FlowColumn{}
+	for i, c := range flow.Columns {
+		if keep[i] {
+			columns = append(columns, c)
+		}
+	}
+	for j, p := range flow.Points {
+		counts := []int{}
+		for i, n := range p.Counts {
+			if keep[i] {
+				counts = append(counts, n)
+			}
+		}
+		flow.Points[j].Counts = counts
+	}
+	flow.Columns = columns
+	return flow
+}
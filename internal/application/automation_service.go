@@ -0,0 +1,452 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// automationScanInterval is how often the scheduler checks for cards whose due date passed.
+const automationScanInterval = time.Minute
+
+var (
+	triggerKinds   = []string{domain.TriggerCardCreated, domain.TriggerCardMoved, domain.TriggerDuePassed}
+	conditionKinds = []string{domain.ConditionColumn, domain.ConditionPriority, domain.ConditionField, domain.ConditionTitleContains}
+	actionKinds    = []string{domain.ActionSetPriority, domain.ActionMoveToColumn, domain.ActionSetField, domain.ActionAddFieldOption}
+)
+
+// automationChainKey is the context key of the *automationChain a rule's actions run under.
+type automationChainKey struct{}
+
+// automationChain tracks the rules fired since a user action, for loop protection.
+type automationChain struct {
+	depth int
+	// fired holds "ruleID/cardID" for every rule that already ran in the chain.
+	fired map[string]bool
+}
+
+// AutomationService manages automation rules and runs them.
+type AutomationService struct {
+	rules     domain.AutomationRepository
+	cards     domain.CardRepository
+	columns   domain.ColumnRepository
+	boards    domain.BoardRepository
+	fields    domain.CustomFieldRepository
+	reminders domain.ReminderRepository
+	cardSvc   *CardService
+	fieldSvc  *CustomFieldService
+	now       func() time.Time
+}
+
+// NewAutomationService creates the service and subscribes it to feed.
+func NewAutomationService(
+	rules domain.AutomationRepository,
+	cards domain.CardRepository,
+	columns domain.ColumnRepository,
+	boards domain.BoardRepository,
+	fields domain.CustomFieldRepository,
+	reminders domain.ReminderRepository,
+	cardSvc *CardService,
+	fieldSvc *CustomFieldService,
+	feed *ActivityFeed,
+) *AutomationService {
+	s := &AutomationService{
+		rules: rules, cards: cards, columns: columns, boards: boards, fields: fields, reminders: reminders,
+		cardSvc: cardSvc, fieldSvc: fieldSvc, now: time.Now,
+	}
+	feed.SubscribeCards(s.handle)
+	return s
+}
+
+func (s *AutomationService) List(ctx context.Context, boardID string) ([]domain.AutomationRule, error) {
+	return s.rules.GetByBoardID(ctx, boardID)
+}
+
+func (s *AutomationService) Create(ctx context.Context, boardID string, in domain.AutomationRuleInput) (*domain.AutomationRule, error) {
+	in, err := s.validate(ctx, boardID, in)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	rule := &domain.AutomationRule{
+		ID: uuid.New().String(), BoardID: boardID, Name: in.Name, Enabled: in.Enabled,
+		Trigger: in.Trigger, Conditions: in.Conditions, Actions: in.Actions,
+		CreatedAt: now, UpdatedAt: now,
+	}
+	if err := s.rules.Create(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (s *AutomationService) Update(ctx context.Context, id string, in domain.AutomationRuleInput) (*domain.AutomationRule, error) {
+	rule, err := s.rules.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if in, err = s.validate(ctx, rule.BoardID, in); err != nil {
+		return nil, err
+	}
+	rule.Name, rule.Enabled, rule.Trigger = in.Name, in.Enabled, in.Trigger
+	rule.Conditions, rule.Actions = in.Conditions, in.Actions
+	rule.UpdatedAt = time.Now().UTC()
+	if err := s.rules.Update(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (s *AutomationService) Delete(ctx context.Context, id string) error {
+	return s.rules.Delete(ctx, id)
+}
+
+// Runs returns a board's newest execution log entries first; limit is capped at
+// domain.MaxAutomationRuns, and zero or less means all of them.
+func (s *AutomationService) Runs(ctx context.Context, boardID string, limit int) ([]domain.AutomationRun, error) {
+	if limit <= 0 || limit > domain.MaxAutomationRuns {
+		limit = domain.MaxAutomationRuns
+	}
+	return s.rules.Runs(ctx, boardID, limit)
+}
+
+// validate checks a rule against its board and returns it trimmed, with field
+// values in canonical stored form.
+func (s *AutomationService) validate(ctx context.Context, boardID string, in domain.AutomationRuleInput) (domain.AutomationRuleInput, error) {
+	board, err := s.boards.GetByID(ctx, boardID)
+	if err != nil {
+		return in, err
+	}
+	cols, err := s.columns.GetByBoardID(ctx, boardID)
+	if err != nil {
+		return in, err
+	}
+	fields, err := s.fields.GetByBoardID(ctx, boardID)
+	if err != nil {
+		return in, err
+	}
+	onBoard := func(id string) bool {
+		return slices.ContainsFunc(cols, func(c domain.Column) bool { return c.ID == id })
+	}
+	field := func(id string) *domain.CustomField {
+		i := slices.IndexFunc(fields, func(f domain.CustomField) bool { return f.ID == id })
+		if i < 0 {
+			return nil
+		}
+		return &fields[i]
+	}
+
+	in.Name = strings.TrimSpace(in.Name)
+	v := domain.NewValidator()
+	v.Title("name", in.Name, domain.MaxAutomationNameLength)
+
+	if !slices.Contains(triggerKinds, in.Trigger.Kind) {
+		v.Add("trigger.kind", domain.CodeInvalidValue, "trigger.kind must be one of "+strings.Join(triggerKinds, ", "))
+	}
+	if in.Trigger.ColumnID != "" && !onBoard(in.Trigger.ColumnID) {
+		v.Add("trigger.column_id", domain.CodeWrongBoard, "trigger.column_id is not a column of this board")
+	}
+
+	if len(in.Conditions) > domain.MaxAutomationConditions {
+		v.Add("conditions", domain.CodeInvalidValue, fmt.Sprintf("a rule can have at most %d conditions", domain.MaxAutomationConditions))
+	}
+	for _, c := range in.Conditions {
+		value, _ := c.Value.(string)
+		switch c.Kind {
+		case domain.ConditionColumn:
+			if !onBoard(c.ColumnID) {
+				v.Add("conditions", domain.CodeWrongBoard, "column "+c.ColumnID+" is not a column of this board")
+			}
+		case domain.ConditionPriority:
+			v.Priority("conditions", strings.TrimSuffix(value, domain.PriorityAtLeastSuffix), board.Priorities)
+		case domain.ConditionField:
+			if field(c.FieldID) == nil {
+				v.Add("conditions", domain.CodeWrongBoard, "field "+c.FieldID+" is not a field of this board")
+			}
+		case domain.ConditionTitleContains:
+			if strings.TrimSpace(value) == "" {
+				v.Add("conditions", domain.CodeRequired, "title_contains needs some text")
+			}
+		default:
+			v.Add("conditions", domain.CodeInvalidValue, "condition kind must be one of "+strings.Join(conditionKinds, ", "))
+		}
+	}
+
+	if len(in.Actions) == 0 {
+		v.Add("actions", domain.CodeRequired, "a rule needs at least one action")
+	}
+	if len(in.Actions) > domain.MaxAutomationActions {
+		v.Add("actions", domain.CodeInvalidValue, fmt.Sprintf("a rule can have at most %d actions", domain.MaxAutomationActions))
+	}
+	for i, a := range in.Actions {
+		value, _ := a.Value.(string)
+		switch a.Kind {
+		case domain.ActionSetPriority:
+			v.Priority("actions", value, board.Priorities)
+		case domain.ActionMoveToColumn:
+			if !onBoard(a.ColumnID) {
+				v.Add("actions", domain.CodeWrongBoard, "column "+a.ColumnID+" is not a column of this board")
+			}
+		case domain.ActionSetField:
+			f := field(a.FieldID)
+			if f == nil {
+				v.Add("actions", domain.CodeWrongBoard, "field "+a.FieldID+" is not a field of this board")
+				continue
+			}
+			normalized, ok, msg := f.NormalizeValue(a.Value)
+			if !ok {
+				v.Add("actions", domain.CodeInvalidValue, msg)
+			}
+			in.Actions[i].Value = normalized
+		case domain.ActionAddFieldOption:
+			f := field(a.FieldID)
+			switch {
+			case f == nil:
+				v.Add("actions", domain.CodeWrongBoard, "field "+a.FieldID+" is not a field of this board")
+			case f.Type != domain.FieldMultiSelect:
+				v.Add("actions", domain.CodeInvalidValue, f.Name+" is not a multi-select field")
+			case !slices.Contains(f.Options, value):
+				v.Add("actions", domain.CodeInvalidValue, f.Name+" has no option "+value)
+			}
+		default:
+			v.Add("actions", domain.CodeInvalidValue, "action kind must be one of "+strings.Join(actionKinds, ", "))
+		}
+	}
+	return in, v.Err()
+}
+
+// handle runs the rules of the activity's board that it triggers.
+func (s *AutomationService) handle(ctx context.Context, a domain.CardActivity) {
+	rules, err := s.rules.GetByBoardID(ctx, a.BoardID)
+	if err != nil {
+		log.Printf("Warning: automation: %v", err)
+		return
+	}
+	for _, rule := range rules {
+		if !rule.Enabled || rule.Trigger.Kind != a.Kind {
+			continue
+		}
+		if rule.Trigger.ColumnID != "" && rule.Trigger.ColumnID != a.ColumnID {
+			continue
+		}
+		if _, err := s.fire(ctx, &rule, a.CardID); err != nil {
+			log.Printf("Warning: automation %s: %v", rule.Name, err)
+		}
+	}
+}
+
+// fire runs rule for a card when its conditions hold and records the run. It returns
+// nil without running anything when the conditions do not hold or the card is gone.
+// A rule that already ran for the card earlier in the same chain, or one past
+// domain.MaxAutomationDepth, is blocked and logged instead of run.
+func (s *AutomationService) fire(ctx context.Context, rule *domain.AutomationRule, cardID string) (*domain.AutomationRun, error) {
+	card, err := s.cards.GetByID(ctx, cardID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if ok, err := s.matches(ctx, rule, card); err != nil || !ok {
+		return nil, err
+	}
+
+	chain, _ := ctx.Value(automationChainKey{}).(*automationChain)
+	if chain == nil {
+		chain = &automationChain{fired: make(map[string]bool)}
+	}
+	run := &domain.AutomationRun{
+		ID: uuid.New().String(), RuleID: rule.ID, RuleName: rule.Name, BoardID: rule.BoardID,
+		CardID: cardID, Trigger: rule.Trigger.Kind, RanAt: s.now().UTC(),
+	}
+	key := rule.ID + "/" + cardID
+	switch {
+	case chain.fired[key]:
+		run.Status, run.Message = domain.AutomationLoopBlocked, "the rule already ran for this card in the same chain"
+	case chain.depth >= domain.MaxAutomationDepth:
+		run.Status = domain.AutomationLoopBlocked
+		run.Message = fmt.Sprintf("more than %d rules ran in a chain", domain.MaxAutomationDepth)
+	default:
+		chain.fired[key] = true
+		next := context.WithValue(ctx, automationChainKey{}, &automationChain{depth: chain.depth + 1, fired: chain.fired})
+		if err := s.runActions(next, rule, cardID); err != nil {
+			run.Status, run.Message = domain.AutomationFailed, err.Error()
+		} else {
+			run.Status = domain.AutomationSucceeded
+		}
+	}
+	if err := s.rules.LogRun(ctx, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// matches reports whether card satisfies the rule's trigger column and every condition.
+func (s *AutomationService) matches(ctx context.Context, rule *domain.AutomationRule, card *domain.Card) (bool, error) {
+	if rule.Trigger.Kind == domain.TriggerDuePassed && rule.Trigger.ColumnID != "" && rule.Trigger.ColumnID != card.ColumnID {
+		return false, nil
+	}
+	for _, c := range rule.Conditions {
+		value, _ := c.Value.(string)
+		switch c.Kind {
+		case domain.ConditionColumn:
+			if card.ColumnID != c.ColumnID {
+				return false, nil
+			}
+		case domain.ConditionPriority:
+			board, err := s.boards.GetByID(ctx, rule.BoardID)
+			if err != nil {
+				return false, err
+			}
+			if !domain.MatchesPriority(board.Priorities, card.Priority, value) {
+				return false, nil
+			}
+		case domain.ConditionField:
+			f, err := s.fields.GetByID(ctx, c.FieldID)
+			if errors.Is(err, domain.ErrNotFound) {
+				return false, nil
+			}
+			if err != nil {
+				return false, err
+			}
+			if !f.Matches(card.CustomFields[c.FieldID], c.Value) {
+				return false, nil
+			}
+		case domain.ConditionTitleContains:
+			if !strings.Contains(strings.ToLower(card.Title), strings.ToLower(value)) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// runActions applies the rule's actions in order, stopping at the first failure.
+// Actions before a failed one stay applied.
+func (s *AutomationService) runActions(ctx context.Context, rule *domain.AutomationRule, cardID string) error {
+	for i, a := range rule.Actions {
+		if err := s.runAction(ctx, a, cardID); err != nil {
+			return fmt.Errorf("action %d (%s): %w", i+1, a.Kind, err)
+		}
+	}
+	return nil
+}
+
+func (s *AutomationService) runAction(ctx context.Context, a domain.AutomationAction, cardID string) error {
+	value, _ := a.Value.(string)
+	switch a.Kind {
+	case domain.ActionSetPriority:
+		_, err := s.cardSvc.Update(ctx, cardID, domain.CardUpdate{Priority: &value})
+		return err
+
+	case domain.ActionMoveToColumn:
+		card, err := s.cards.GetByID(ctx, cardID)
+		if err != nil {
+			return err
+		}
+		if card.ColumnID == a.ColumnID {
+			return nil
+		}
+		maxPos, err := s.cards.MaxPosition(ctx, a.ColumnID)
+		if err != nil {
+			return err
+		}
+		return s.cardSvc.Move(ctx, cardID, a.ColumnID, maxPos+1000, false)
+
+	case domain.ActionSetField:
+		_, err := s.fieldSvc.SetValue(ctx, cardID, a.FieldID, a.Value)
+		return err
+
+	case domain.ActionAddFieldOption:
+		card, err := s.cards.GetByID(ctx, cardID)
+		if err != nil {
+			return err
+		}
+		options, _ := card.CustomFields[a.FieldID].([]any)
+		if slices.Contains(options, any(value)) {
+			return nil
+		}
+		_, err = s.fieldSvc.SetValue(ctx, cardID, a.FieldID, append(slices.Clone(options), value))
+		return err
+	}
+	return fmt.Errorf("unknown action kind %q", a.Kind)
+}
+
+// Run scans for overdue cards immediately and then every minute, passing each batch
+// of due_passed runs to done. A failed scan is logged and retried on the next tick.
+// It blocks until ctx is cancelled.
+func (s *AutomationService) Run(ctx context.Context, done func([]domain.AutomationRun)) error {
+	ticker := time.NewTicker(automationScanInterval)
+	defer ticker.Stop()
+
+	for {
+		runs, err := s.RunDue(ctx)
+		if err != nil {
+			log.Printf("Warning: automation scan: %v", err)
+		}
+		if len(runs) > 0 {
+			done(runs)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunDue fires the enabled due_passed rules for open cards whose due date has
+// passed, at most once per rule, card and due date. A rule that fails for a card
+// is logged and skipped, so it cannot hold up the others.
+func (s *AutomationService) RunDue(ctx context.Context) ([]domain.AutomationRun, error) {
+	rules, err := s.rules.ListEnabled(ctx, domain.TriggerDuePassed)
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+	candidates, err := s.reminders.ListCandidates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	var out []domain.AutomationRun
+	for _, c := range candidates {
+		if domain.DueDeadline(c.DueAt, c.AllDay, time.Local).After(now) {
+			continue
+		}
+		for _, rule := range rules {
+			if rule.BoardID != c.BoardID {
+				continue
+			}
+			fired, err := s.rules.DueFired(ctx, rule.ID, c.CardID, c.DueAt)
+			if err != nil {
+				log.Printf("Warning: automation %s: %v", rule.Name, err)
+				continue
+			}
+			if fired {
+				continue
+			}
+			run, err := s.fire(ctx, &rule, c.CardID)
+			if err != nil {
+				log.Printf("Warning: automation %s: %v", rule.Name, err)
+				continue
+			}
+			if run == nil {
+				continue
+			}
+			if err := s.rules.MarkDueFired(ctx, rule.ID, c.CardID, c.DueAt); err != nil {
+				log.Printf("Warning: automation %s: %v", rule.Name, err)
+			}
+			out = append(out, *run)
+		}
+	}
+	return out, nil
+}
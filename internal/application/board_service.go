@@ -3,82 +3,166 @@ package application
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
-	"github.com/google/uuid"
-
 	"kanban-app-playground/internal/domain"
 )
 
 type BoardService struct {
-	boards  domain.BoardRepository
-	columns domain.ColumnRepository
-	cards   domain.CardRepository
+	boards    domain.BoardRepository
+	columns   domain.ColumnRepository
+	cards     domain.CardRepository
+	templates domain.BoardTemplateRepository
+	fields    domain.CustomFieldRepository
+	workflows domain.WorkflowRepository
+	activity  *ActivityFeed
+	tx        domain.Transactor
 }
 
 func NewBoardService(
 	boards domain.BoardRepository,
 	columns domain.ColumnRepository,
 	cards domain.CardRepository,
+	templates domain.BoardTemplateRepository,
+	fields domain.CustomFieldRepository,
+	workflows domain.WorkflowRepository,
+	activity *ActivityFeed,
+	tx domain.Transactor,
 ) *BoardService {
-	return &BoardService{boards: boards, columns: columns, cards: cards}
+	return &BoardService{
+		boards: boards, columns: columns, cards: cards, templates: templates,
+		fields: fields, workflows: workflows, activity: activity, tx: tx,
+	}
 }
 
 func (s *BoardService) GetAll(ctx context.Context) ([]domain.Board, error) {
 	return s.boards.GetAll(ctx)
 }
 
-// Create creates a new board with 3 default columns (待辦, 進行中, 完成).
+// Create creates a new board with the default columns (待辦, 進行中, 完成).
 func (s *BoardService) Create(ctx context.Context, title string) (*domain.Board, error) {
-	if title == "" {
-		return nil, fmt.Errorf("%w: board title cannot be empty", domain.ErrValidation)
-	}
+	return s.CreateFromTemplate(ctx, TemplateDefault, title)
+}
 
-	now := time.Now().UTC()
-	board := &domain.Board{
-		ID:        uuid.New().String(),
-		Title:     title,
-		CreatedAt: now,
-		UpdatedAt: now,
+func (s *BoardService) Update(ctx context.Context, id, title string) (*domain.Board, error) {
+	v := domain.NewValidator()
+	v.Title("title", title, domain.MaxBoardTitleLength)
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 
-	if err := s.boards.Create(ctx, board); err != nil {
-		return nil, fmt.Errorf("create board: %w", err)
+	board, err := s.boards.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
 	}
 
-	defaults := []domain.Column{
-		{ID: uuid.New().String(), BoardID: board.ID, Title: "待辦", Position: 1000, CreatedAt: now},
-		{ID: uuid.New().String(), BoardID: board.ID, Title: "進行中", Position: 2000, CreatedAt: now},
-		{ID: uuid.New().String(), BoardID: board.ID, Title: "完成", Position: 3000, CreatedAt: now},
-	}
-	if err := s.columns.CreateBatch(ctx, defaults); err != nil {
-		return nil, fmt.Errorf("create default columns: %w", err)
+	board.Title = title
+	board.UpdatedAt = time.Now().UTC()
+
+	if err := s.boards.Update(ctx, board); err != nil {
+		return nil, err
 	}
+	return board, nil
+}
+
+// SetFlowColumns marks where work starts and ends on a board for flow metrics.
+// Either ID may be empty to fall back to the default (first active and first done column).
+// Column kinds are the source of truth for flow metrics, so when either column is
+// set the board's columns are reclassified around them (see Board.InferColumnKinds)
+// and completed_at on their cards follows.
+func (s *BoardService) SetFlowColumns(ctx context.Context, id, startColumnID, doneColumnID string) (*domain.Board, error) {
+	var board *domain.Board
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if board, err = s.boards.GetByID(ctx, id); err != nil {
+			return err
+		}
+
+		v := domain.NewValidator()
+		for _, f := range []struct{ field, columnID string }{
+			{"start_column_id", startColumnID},
+			{"done_column_id", doneColumnID},
+		} {
+			if f.columnID == "" {
+				continue
+			}
+			col, err := s.columns.GetByID(ctx, f.columnID)
+			if err != nil {
+				return err
+			}
+			v.ColumnInBoard(f.field, col, id)
+		}
+		if err := v.Err(); err != nil {
+			return err
+		}
 
+		cols, err := s.columns.GetByBoardID(ctx, id)
+		if err != nil {
+			return err
+		}
+		board.StartColumnID, board.DoneColumnID = startColumnID, doneColumnID
+		if start, done := board.FlowColumns(cols); start != nil && start.Position > done.Position {
+			v.Add("start_column_id", domain.CodeOutOfOrder, "start_column_id must not come after done_column_id")
+			return v.Err()
+		}
+		if err := s.boards.SetFlowColumns(ctx, id, startColumnID, doneColumnID); err != nil {
+			return err
+		}
+		if startColumnID == "" && doneColumnID == "" {
+			return nil
+		}
+
+		kinds := slices.Clone(cols)
+		board.InferColumnKinds(kinds)
+		now := time.Now().UTC()
+		for i, c := range kinds {
+			if c.Kind == cols[i].Kind {
+				continue
+			}
+			if err := s.columns.SetKind(ctx, c.ID, c.Kind); err != nil {
+				return err
+			}
+			if err := s.cards.SyncCompleted(ctx, c.ID, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 	return board, nil
 }
 
-func (s *BoardService) Update(ctx context.Context, id, title string) (*domain.Board, error) {
-	if title == "" {
-		return nil, fmt.Errorf("%w: board title cannot be empty", domain.ErrValidation)
+// SetEstimateScale switches the board to another built-in estimate scale. Existing
+// card estimates keep their numeric value, so rollups stay comparable.
+func (s *BoardService) SetEstimateScale(ctx context.Context, id, kind string) (*domain.Board, error) {
+	v := domain.NewValidator()
+	if _, ok := domain.EstimateScales[kind]; !ok {
+		v.Add("estimate_scale", domain.CodeInvalidValue, "estimate_scale must be fibonacci, tshirt or hours")
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 
 	board, err := s.boards.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
-
-	board.Title = title
-	board.UpdatedAt = time.Now().UTC()
-
-	if err := s.boards.Update(ctx, board); err != nil {
+	if err := s.boards.SetEstimateScale(ctx, id, kind); err != nil {
 		return nil, err
 	}
+	board.EstimateScale = kind
 	return board, nil
 }
 
 func (s *BoardService) Delete(ctx context.Context, id string) error {
-	return s.boards.Delete(ctx, id)
+	if err := s.boards.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.activity.publishBoard(ctx, domain.BoardDeleted, id)
+	return nil
 }
 
 // GetWithData loads a board with all its columns and cards in one call.
@@ -102,18 +186,30 @@ func (s *BoardService) GetWithData(ctx context.Context, boardID string) (*BoardD
 		if cards == nil {
 			cards = []domain.Card{}
 		}
-		result = append(result, ColumnWithCards{Column: col, Cards: cards})
+		result = append(result, newColumnWithCards(col, cards))
 	}
 
-	return &BoardData{Board: *board, Columns: result}, nil
+	return newBoardData(*board, result), nil
 }
 
-// FilterCards returns a board's data filtered by priority.
-func (s *BoardService) FilterCards(ctx context.Context, boardID, priority string) (*BoardData, error) {
+// FilterCards returns a board's data filtered by priority, assignee and custom field
+// values. Empty values match everything; priority may end in "+" for that level and
+// above (such as "high+"), and assigneeID may be domain.AssigneeNone for unassigned
+// cards. A card must satisfy every field filter.
+func (s *BoardService) FilterCards(ctx context.Context, boardID, priority, assigneeID string, fieldFilters []domain.FieldFilter) (*BoardData, error) {
 	board, err := s.boards.GetByID(ctx, boardID)
 	if err != nil {
 		return nil, err
 	}
+	defs, err := s.fields.GetByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	v := domain.NewValidator()
+	filterFields := resolveFieldFilters(v, "field_filters", defs, fieldFilters)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
 
 	cols, err := s.columns.GetByBoardID(ctx, boardID)
 	if err != nil {
@@ -126,10 +222,11 @@ func (s *BoardService) FilterCards(ctx context.Context, boardID, priority string
 		if err != nil {
 			return nil, err
 		}
-		if priority != "" {
+		if priority != "" || assigneeID != "" || len(fieldFilters) > 0 {
 			filtered := make([]domain.Card, 0)
 			for _, c := range cards {
-				if c.Priority == priority {
+				if (priority == "" || domain.MatchesPriority(board.Priorities, c.Priority, priority)) && hasAssignee(c, assigneeID) &&
+					matchesFields(c, filterFields, fieldFilters) {
 					filtered = append(filtered, c)
 				}
 			}
@@ -138,13 +235,13 @@ func (s *BoardService) FilterCards(ctx context.Context, boardID, priority string
 		if cards == nil {
 			cards = []domain.Card{}
 		}
-		result = append(result, ColumnWithCards{Column: col, Cards: cards})
+		result = append(result, newColumnWithCards(col, cards))
 	}
 
-	return &BoardData{Board: *board, Columns: result}, nil
+	return newBoardData(*board, result), nil
 }
 
-// SeedIfEmpty creates a sample board on first launch.
+// SeedIfEmpty creates a sample board with starter cards on first launch.
 func (s *BoardService) SeedIfEmpty(ctx context.Context) error {
 	boards, err := s.boards.GetAll(ctx)
 	if err != nil {
@@ -154,40 +251,46 @@ func (s *BoardService) SeedIfEmpty(ctx context.Context) error {
 		return nil
 	}
 
-	board, err := s.Create(ctx, "我的看板")
-	if err != nil {
+	if _, err := s.CreateFromTemplate(ctx, templateWelcome, "我的看板"); err != nil {
 		return fmt.Errorf("seed board: %w", err)
 	}
+	return nil
+}
+
+// hasAssignee reports whether c matches an assignee filter value.
+func hasAssignee(c domain.Card, assigneeID string) bool {
+	switch assigneeID {
+	case "":
+		return true
+	case domain.AssigneeNone:
+		return len(c.AssigneeIDs) == 0
+	default:
+		return slices.Contains(c.AssigneeIDs, assigneeID)
+	}
+}
 
-	cols, err := s.columns.GetByBoardID(ctx, board.ID)
-	if err != nil {
-		return fmt.Errorf("seed get columns: %w", err)
-	}
-
-	now := time.Now().UTC()
-	sampleCards := []domain.Card{
-		{
-			ID: uuid.New().String(), ColumnID: cols[0].ID,
-			Title: "歡迎使用看板！", Description: "這是一張示範卡片，你可以拖曳它到其他欄位",
-			Priority: "medium", Position: 1000, CreatedAt: now, UpdatedAt: now,
-		},
-		{
-			ID: uuid.New().String(), ColumnID: cols[0].ID,
-			Title: "試試建立新卡片", Priority: "low", Position: 2000,
-			CreatedAt: now, UpdatedAt: now,
-		},
-		{
-			ID: uuid.New().String(), ColumnID: cols[1].ID,
-			Title: "探索看板功能", Priority: "high", Position: 1000,
-			CreatedAt: now, UpdatedAt: now,
-		},
-	}
-
-	for i := range sampleCards {
-		if err := s.cards.Create(ctx, &sampleCards[i]); err != nil {
-			return fmt.Errorf("seed card: %w", err)
+// resolveFieldFilters returns the definition of each filter's field among defs,
+// recording a validation error under key for fields that are not on the board.
+func resolveFieldFilters(v *domain.Validator, key string, defs []domain.CustomField, filters []domain.FieldFilter) []*domain.CustomField {
+	fields := make([]*domain.CustomField, len(filters))
+	for i, ff := range filters {
+		j := slices.IndexFunc(defs, func(f domain.CustomField) bool { return f.ID == ff.FieldID })
+		if j < 0 {
+			v.Add(key, domain.CodeWrongBoard, "field "+ff.FieldID+" is not a field of this board")
+			continue
 		}
+		fields[i] = &defs[j]
 	}
+	return fields
+}
 
-	return nil
+// matchesFields reports whether c satisfies every field filter; fields[i] is the
+// definition of filters[i].
+func matchesFields(c domain.Card, fields []*domain.CustomField, filters []domain.FieldFilter) bool {
+	for i, ff := range filters {
+		if !fields[i].Matches(c.CustomFields[ff.FieldID], ff.Value) {
+			return false
+		}
+	}
+	return true
 }
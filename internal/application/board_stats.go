@@ -0,0 +1,28 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// BoardStats summarises a board's cards and recent activity. Cards in done columns
+// count as completed rather than open.
+func (s *AnalyticsService) BoardStats(ctx context.Context, boardID string) (*domain.BoardStats, error) {
+	if _, err := s.boards.GetByID(ctx, boardID); err != nil {
+		return nil, err
+	}
+
+	now := s.now().In(s.loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, s.loc)
+	daysToMonday := (8 - int(today.Weekday())) % 7
+	if daysToMonday == 0 {
+		daysToMonday = 7
+	}
+	return s.stats.BoardStats(ctx, boardID, domain.StatsCutoffs{
+		Now:     now,
+		Today:   today,
+		WeekEnd: today.AddDate(0, 0, daysToMonday),
+	})
+}
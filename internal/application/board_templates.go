@@ -0,0 +1,346 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// Built-in template IDs. They are prefixed so they can never collide with the
+// UUIDs of user-defined templates.
+const (
+	TemplateDefault   = "builtin:default"
+	TemplateScrum     = "builtin:scrum"
+	TemplateBugTriage = "builtin:bug-triage"
+	TemplateGTD       = "builtin:gtd"
+
+	// templateWelcome seeds the first board; it is not listed to users.
+	templateWelcome = "builtin:welcome"
+)
+
+func templateColumns(titles ...string) []domain.TemplateColumn {
+	cols := make([]domain.TemplateColumn, len(titles))
+	for i, t := range titles {
+		cols[i] = domain.TemplateColumn{Title: t}
+	}
+	return cols
+}
+
+// builtinBoardTemplates returns the templates that ship with the app, in display order.
+func builtinBoardTemplates() []domain.BoardTemplate {
+	return []domain.BoardTemplate{
+		{
+			ID: TemplateDefault, Name: "基本看板", Description: "待辦、進行中、完成三個欄位",
+			Columns: templateColumns("待辦", "進行中", "完成"),
+		},
+		{
+			ID: TemplateScrum, Name: "Scrum", Description: "產品待辦清單到完成的衝刺流程",
+			Columns: templateColumns("產品待辦", "衝刺待辦", "進行中", "審查中", "完成"),
+		},
+		{
+			ID: TemplateBugTriage, Name: "Bug 分類", Description: "回報、分類、修復與驗證",
+			Columns: templateColumns("新回報", "分類中", "修復中", "待驗證", "已關閉"),
+		},
+		{
+			ID: TemplateGTD, Name: "個人 GTD", Description: "收件匣、下一步行動與等待中",
+			Columns: []domain.TemplateColumn{
+				{Title: "收件匣", Kind: domain.ColumnKindBacklog},
+				{Title: "下一步", Kind: domain.ColumnKindActive},
+				{Title: "等待中", Kind: domain.ColumnKindActive},
+				{Title: "將來/也許", Kind: domain.ColumnKindBacklog},
+				{Title: "完成", Kind: domain.ColumnKindDone},
+			},
+		},
+	}
+}
+
+// welcomeTemplate is the default layout plus sample cards, used by SeedIfEmpty.
+func welcomeTemplate() domain.BoardTemplate {
+	tpl := builtinBoardTemplates()[0]
+	tpl.ID = templateWelcome
+	tpl.Cards = []domain.TemplateCard{
+		{ColumnIndex: 0, Title: "歡迎使用看板！", Description: "這是一張示範卡片，你可以拖曳它到其他欄位", Priority: domain.PriorityMedium},
+		{ColumnIndex: 0, Title: "試試建立新卡片", Priority: domain.PriorityLow},
+		{ColumnIndex: 1, Title: "探索看板功能", Priority: domain.PriorityHigh},
+	}
+	return tpl
+}
+
+// ListTemplates returns built-in templates followed by user-defined ones.
+func (s *BoardService) ListTemplates(ctx context.Context) ([]domain.BoardTemplate, error) {
+	builtins := builtinBoardTemplates()
+	for i := range builtins {
+		builtins[i].BuiltIn = true
+	}
+	custom, err := s.templates.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	all := append(builtins, custom...)
+	for i := range all {
+		if all[i].Cards == nil {
+			all[i].Cards = []domain.TemplateCard{}
+		}
+	}
+	return all, nil
+}
+
+func (s *BoardService) getTemplate(ctx context.Context, id string) (*domain.BoardTemplate, error) {
+	if id == templateWelcome {
+		tpl := welcomeTemplate()
+		return &tpl, nil
+	}
+	for _, tpl := range builtinBoardTemplates() {
+		if tpl.ID == id {
+			tpl.BuiltIn = true
+			return &tpl, nil
+		}
+	}
+	return s.templates.GetByID(ctx, id)
+}
+
+// SaveAsTemplate captures a board's columns, priority levels, custom fields, workflow,
+// estimate scale and flow columns, and optionally its cards, as a user template.
+func (s *BoardService) SaveAsTemplate(ctx context.Context, boardID, name string, includeCards bool) (*domain.BoardTemplate, error) {
+	v := domain.NewValidator()
+	v.Title("name", name, domain.MaxBoardTitleLength)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	data, err := s.GetWithData(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := s.fields.GetByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	workflow, err := s.workflows.GetByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	tpl := &domain.BoardTemplate{
+		ID:            uuid.New().String(),
+		Name:          name,
+		Description:   fmt.Sprintf("由「%s」建立", data.Board.Title),
+		Columns:       make([]domain.TemplateColumn, 0, len(data.Columns)),
+		Cards:         []domain.TemplateCard{},
+		Priorities:    data.Board.Priorities,
+		Fields:        make([]domain.CustomFieldInput, len(fields)),
+		EstimateScale: data.Board.EstimateScale,
+		CreatedAt:     time.Now().UTC(),
+	}
+	fieldIndex := make(map[string]int, len(fields))
+	for i, f := range fields {
+		tpl.Fields[i] = domain.CustomFieldInput{Name: f.Name, Type: f.Type, Options: f.Options}
+		fieldIndex[f.ID] = i
+	}
+	colIndex := make(map[string]int, len(data.Columns))
+	for i, col := range data.Columns {
+		colIndex[col.Column.ID] = i
+		if col.Column.ID == data.Board.StartColumnID {
+			tpl.StartColumn = &i
+		}
+		if col.Column.ID == data.Board.DoneColumnID {
+			tpl.DoneColumn = &i
+		}
+		tpl.Columns = append(tpl.Columns, domain.TemplateColumn{Title: col.Column.Title, Kind: col.Column.Kind})
+		if !includeCards {
+			continue
+		}
+		for _, c := range col.Cards {
+			tc := domain.TemplateCard{
+				ColumnIndex: i, Title: c.Title, Description: c.Description, Priority: c.Priority,
+			}
+			for fieldID, value := range c.CustomFields {
+				fi, ok := fieldIndex[fieldID]
+				if !ok {
+					return nil, fmt.Errorf("card %s has a value for field %s, which is not on board %s", c.ID, fieldID, boardID)
+				}
+				if tc.FieldValues == nil {
+					tc.FieldValues = make(map[int]any, len(c.CustomFields))
+				}
+				tc.FieldValues[fi] = value
+			}
+			tpl.Cards = append(tpl.Cards, tc)
+		}
+	}
+	// index resolves a workflow column to its template index; the workflow must only
+	// name columns on this board.
+	index := func(columnID string) (int, error) {
+		i, ok := colIndex[columnID]
+		if !ok {
+			return 0, fmt.Errorf("workflow of board %s names column %s, which is not on the board", boardID, columnID)
+		}
+		return i, nil
+	}
+	for from, targets := range workflow.Transitions {
+		if tpl.Transitions == nil {
+			tpl.Transitions = make(map[int][]int, len(workflow.Transitions))
+		}
+		fi, err := index(from)
+		if err != nil {
+			return nil, err
+		}
+		to := make([]int, 0, len(targets))
+		for _, t := range targets {
+			ti, err := index(t)
+			if err != nil {
+				return nil, err
+			}
+			to = append(to, ti)
+		}
+		tpl.Transitions[fi] = to
+	}
+
+	if err := s.templates.Create(ctx, tpl); err != nil {
+		return nil, err
+	}
+	return tpl, nil
+}
+
+// DeleteTemplate removes a user-defined template. Built-in templates cannot be deleted.
+func (s *BoardService) DeleteTemplate(ctx context.Context, id string) error {
+	if strings.HasPrefix(id, "builtin:") {
+		return fmt.Errorf("%w: built-in templates cannot be deleted", domain.ErrValidation)
+	}
+	return s.templates.Delete(ctx, id)
+}
+
+// CreateFromTemplate creates a new board laid out like the given template.
+func (s *BoardService) CreateFromTemplate(ctx context.Context, templateID, title string) (*domain.Board, error) {
+	v := domain.NewValidator()
+	v.Title("title", title, domain.MaxBoardTitleLength)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	tpl, err := s.getTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tpl.Columns) == 0 {
+		return nil, fmt.Errorf("%w: template %s has no columns", domain.ErrValidation, templateID)
+	}
+
+	now := time.Now().UTC()
+	board := &domain.Board{
+		ID:            uuid.New().String(),
+		Title:         title,
+		EstimateScale: domain.EstimateScaleFibonacci,
+		Priorities:    domain.DefaultPriorities(),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if len(tpl.Priorities) > 0 {
+		board.Priorities = tpl.Priorities
+	}
+	if _, ok := domain.EstimateScales[tpl.EstimateScale]; ok {
+		board.EstimateScale = tpl.EstimateScale
+	}
+
+	cols := make([]domain.Column, len(tpl.Columns))
+	for i, tc := range tpl.Columns {
+		cols[i] = domain.Column{
+			ID: uuid.New().String(), BoardID: board.ID, Title: tc.Title,
+			Position: (i + 1) * 1000, CreatedAt: now,
+		}
+	}
+	if i := tpl.StartColumn; i != nil && *i >= 0 && *i < len(cols) {
+		board.StartColumnID = cols[*i].ID
+	}
+	if i := tpl.DoneColumn; i != nil && *i >= 0 && *i < len(cols) {
+		board.DoneColumnID = cols[*i].ID
+	}
+	board.InferColumnKinds(cols)
+	for i, tc := range tpl.Columns {
+		if tc.Kind != "" {
+			cols[i].Kind = tc.Kind
+		}
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.boards.Create(ctx, board); err != nil {
+			return fmt.Errorf("create board: %w", err)
+		}
+		if err := s.columns.CreateBatch(ctx, cols); err != nil {
+			return fmt.Errorf("create template columns: %w", err)
+		}
+		if board.StartColumnID != "" || board.DoneColumnID != "" {
+			if err := s.boards.SetFlowColumns(ctx, board.ID, board.StartColumnID, board.DoneColumnID); err != nil {
+				return err
+			}
+		}
+
+		fieldIDs := make([]string, len(tpl.Fields))
+		for i, tf := range tpl.Fields {
+			f := &domain.CustomField{
+				ID: uuid.New().String(), BoardID: board.ID, Name: tf.Name, Type: tf.Type,
+				Options: tf.Options, Position: i, CreatedAt: now,
+			}
+			if err := s.fields.Create(ctx, f); err != nil {
+				return fmt.Errorf("create template field: %w", err)
+			}
+			fieldIDs[i] = f.ID
+		}
+
+		if len(tpl.Transitions) > 0 {
+			w := &domain.Workflow{BoardID: board.ID, Transitions: make(map[string][]string, len(tpl.Transitions))}
+			for from, targets := range tpl.Transitions {
+				if from < 0 || from >= len(cols) {
+					continue
+				}
+				to := make([]string, 0, len(targets))
+				for _, t := range targets {
+					if t >= 0 && t < len(cols) {
+						to = append(to, cols[t].ID)
+					}
+				}
+				w.Transitions[cols[from].ID] = to
+			}
+			if err := s.workflows.Replace(ctx, w); err != nil {
+				return err
+			}
+		}
+
+		positions := make([]int, len(cols))
+		for _, tc := range tpl.Cards {
+			if tc.ColumnIndex < 0 || tc.ColumnIndex >= len(cols) {
+				continue
+			}
+			// Templates saved before priorities were captured fall back to the default level.
+			priority := domain.MapPriority(tc.Priority, nil, board.Priorities)
+			positions[tc.ColumnIndex] += 1000
+			card := &domain.Card{
+				ID: uuid.New().String(), ColumnID: cols[tc.ColumnIndex].ID,
+				Title: tc.Title, Description: tc.Description, Priority: priority,
+				Position: positions[tc.ColumnIndex], CreatedAt: now, UpdatedAt: now,
+			}
+			for i, value := range tc.FieldValues {
+				if i >= 0 && i < len(fieldIDs) {
+					if card.CustomFields == nil {
+						card.CustomFields = make(map[string]any, len(tc.FieldValues))
+					}
+					card.CustomFields[fieldIDs[i]] = value
+				}
+			}
+			if err := s.cards.Create(ctx, card); err != nil {
+				return fmt.Errorf("create template card: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.activity.publishBoard(ctx, domain.BoardCreated, board.ID)
+	return board, nil
+}
@@ -0,0 +1,261 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// BulkItem is the outcome of a bulk operation for a single card.
+type BulkItem struct {
+	ID  string
+	Err error
+}
+
+// BulkResult is the outcome of a bulk card operation.
+//
+// What: Per-card results plus the boards whose contents changed.
+// Why: Triage touches many cards at once; one bad ID should not block the rest,
+// and the caller needs to know which boards to refresh.
+// When: Returned by the CardService.Bulk* methods.
+type BulkResult struct {
+	Items    []BulkItem
+	BoardIDs []string
+}
+
+// bulkOp applies one bulk operation to a card in the column it currently sits in.
+type bulkOp func(ctx context.Context, card *domain.Card, col *domain.Column) error
+
+// isItemError reports whether err concerns only the current item. Any other error
+// aborts the whole bulk operation.
+func isItemError(err error) bool {
+	return errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrValidation) ||
+		errors.Is(err, domain.ErrTransitionNotAllowed)
+}
+
+// runBulk applies op to every card in ids inside a single transaction. Item errors
+// are collected into the result; any other error rolls everything back.
+// Operations must validate before writing so a failed item leaves no partial changes.
+// The cards that succeeded are snapshotted first and recorded as one undo entry of kind.
+func (s *CardService) runBulk(ctx context.Context, kind string, ids []string, op bulkOp) (*BulkResult, error) {
+	res := &BulkResult{Items: make([]BulkItem, 0, len(ids)), BoardIDs: []string{}}
+	seen := make(map[string]bool, len(ids))
+	touched := make(map[string]bool)
+
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var snapshots []domain.Card
+		for _, id := range ids {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+
+			card, err := s.cards.GetByID(ctx, id)
+			var col *domain.Column
+			if err == nil {
+				col, err = s.columns.GetByID(ctx, card.ColumnID)
+			}
+			if err == nil {
+				err = op(ctx, card, col)
+			}
+			if err != nil && !isItemError(err) {
+				return err
+			}
+			if err == nil {
+				snapshots = append(snapshots, *card)
+				if !touched[col.BoardID] {
+					touched[col.BoardID] = true
+					res.BoardIDs = append(res.BoardIDs, col.BoardID)
+				}
+			}
+			res.Items = append(res.Items, BulkItem{ID: id, Err: err})
+		}
+		if len(snapshots) == 0 {
+			return nil
+		}
+		return s.undo.Push(ctx, &domain.UndoEntry{
+			ID: uuid.New().String(), Kind: kind, Cards: snapshots, CreatedAt: time.Now().UTC(),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Undo reverses the most recent bulk operation by restoring its card snapshots,
+// re-creating deleted cards. A card whose column has since been deleted fails its
+// own item. Each restored card gets the history that takes it back: a move to its
+// old column, or a restored event when it had been deleted or archived. Those cards
+// are then announced like a move or a create, so automations and script hooks see
+// them. It returns ErrNotFound when there is nothing to undo.
+func (s *CardService) Undo(ctx context.Context) (*BulkResult, error) {
+	var res *BulkResult
+	var announce []domain.CardActivity
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		entry, err := s.undo.Latest(ctx)
+		if err != nil {
+			return err
+		}
+		res = &BulkResult{Items: make([]BulkItem, 0, len(entry.Cards)), BoardIDs: []string{}}
+		touched := make(map[string]bool)
+		now := time.Now().UTC()
+		for i := range entry.Cards {
+			card := &entry.Cards[i]
+			col, err := s.columns.GetByID(ctx, card.ColumnID)
+			var a *domain.CardActivity
+			if err == nil {
+				a, err = s.restore(ctx, card, col, now)
+			}
+			if err != nil && !isItemError(err) {
+				return err
+			}
+			if a != nil {
+				announce = append(announce, *a)
+			}
+			if err == nil && !touched[col.BoardID] {
+				touched[col.BoardID] = true
+				res.BoardIDs = append(res.BoardIDs, col.BoardID)
+			}
+			res.Items = append(res.Items, BulkItem{ID: card.ID, Err: err})
+		}
+		return s.undo.Delete(ctx, entry.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range announce {
+		s.activity.publish(ctx, a)
+	}
+	return res, nil
+}
+
+// restore writes back snap, a card snapshot in col, and records the history that
+// takes the card there from where it is now. It returns the activity to announce
+// once the change is saved, or nil when the card stays in its column or off the board.
+func (s *CardService) restore(ctx context.Context, snap *domain.Card, col *domain.Column, at time.Time) (*domain.CardActivity, error) {
+	current, err := s.cards.GetByID(ctx, snap.ID)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+	if err := s.cards.Restore(ctx, snap); err != nil {
+		return nil, err
+	}
+
+	gone := current == nil || current.ArchivedAt != nil
+	switch {
+	case snap.ArchivedAt != nil:
+		if gone {
+			return nil, nil
+		}
+		from, err := s.columns.GetByID(ctx, current.ColumnID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.history.Record(ctx, &domain.CardEvent{
+			CardID: snap.ID, BoardID: from.BoardID, Kind: domain.CardEventArchived, FromColumnID: from.ID, At: at,
+		})
+	case gone:
+		if err := s.history.Record(ctx, &domain.CardEvent{
+			CardID: snap.ID, BoardID: col.BoardID, Kind: domain.CardEventRestored, ToColumnID: col.ID, At: at,
+		}); err != nil {
+			return nil, err
+		}
+		return &domain.CardActivity{Kind: domain.TriggerCardCreated, CardID: snap.ID, BoardID: col.BoardID, ColumnID: col.ID}, nil
+	}
+
+	from, err := s.columns.GetByID(ctx, current.ColumnID)
+	if err != nil {
+		return nil, err
+	}
+	if from.ID == col.ID {
+		return nil, nil
+	}
+	if err := recordMove(ctx, s.history, snap.ID, from, col, at); err != nil {
+		return nil, err
+	}
+	return &domain.CardActivity{
+		Kind: domain.TriggerCardMoved, CardID: snap.ID, BoardID: col.BoardID, ColumnID: col.ID, FromColumnID: from.ID,
+	}, nil
+}
+
+// BulkUpdate applies the same partial update to every card in ids.
+func (s *CardService) BulkUpdate(ctx context.Context, ids []string, updates domain.CardUpdate) (*BulkResult, error) {
+	return s.runBulk(ctx, domain.UndoBulkUpdate, ids, func(ctx context.Context, card *domain.Card, _ *domain.Column) error {
+		_, err := s.Update(ctx, card.ID, updates)
+		return err
+	})
+}
+
+// BulkMove moves every card in ids to the end of columnID, keeping their relative order.
+// Like Move, it only moves cards within their own board and follows its workflow.
+// After the transaction commits, each moved card is announced on the activity feed
+// like a single move, so automations and script hooks run once per moved card.
+func (s *CardService) BulkMove(ctx context.Context, ids []string, columnID string) (*BulkResult, error) {
+	target, err := s.columns.GetByID(ctx, columnID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	next := -1
+	var moved []domain.CardActivity
+
+	res, err := s.runBulk(ctx, domain.UndoBulkMove, ids, func(ctx context.Context, card *domain.Card, col *domain.Column) error {
+		v := domain.NewValidator()
+		v.ColumnInBoard("column_id", target, col.BoardID)
+		if err := v.Err(); err != nil {
+			return err
+		}
+		if card.ColumnID == target.ID {
+			return nil
+		}
+		if err := s.checkTransition(ctx, col, target); err != nil {
+			return err
+		}
+		if next < 0 {
+			maxPos, err := s.cards.MaxPosition(ctx, target.ID)
+			if err != nil {
+				return err
+			}
+			next = maxPos + 1000
+		}
+		if err := s.cards.Move(ctx, card.ID, target.ID, next); err != nil {
+			return err
+		}
+		next += 1000
+		if err := recordMove(ctx, s.history, card.ID, col, target, now); err != nil {
+			return err
+		}
+		moved = append(moved, domain.CardActivity{
+			Kind: domain.TriggerCardMoved, CardID: card.ID, BoardID: target.BoardID, ColumnID: target.ID, FromColumnID: col.ID,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range moved {
+		s.activity.publish(ctx, a)
+	}
+	return res, nil
+}
+
+// BulkDelete deletes every card in ids.
+func (s *CardService) BulkDelete(ctx context.Context, ids []string) (*BulkResult, error) {
+	return s.runBulk(ctx, domain.UndoBulkDelete, ids, func(ctx context.Context, card *domain.Card, _ *domain.Column) error {
+		return s.cards.Delete(ctx, card.ID)
+	})
+}
+
+// BulkArchive archives every card in ids. Archived cards keep their column and
+// position but no longer appear on the board, in search or in reminders.
+func (s *CardService) BulkArchive(ctx context.Context, ids []string) (*BulkResult, error) {
+	now := time.Now().UTC()
+	return s.runBulk(ctx, domain.UndoBulkArchive, ids, func(ctx context.Context, card *domain.Card, _ *domain.Column) error {
+		return s.cards.Archive(ctx, card.ID, now)
+	})
+}
@@ -0,0 +1,100 @@
+package application
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"kanban-app-playground/internal/domain"
+)
+
+func TestUndoRecordsHistoryAndActivity(t *testing.T) {
+	type event struct{ Kind, From, To string }
+	tests := []struct {
+		name     string
+		bulk     func(svc *testServices, cardID, doneID string) (*BulkResult, error)
+		history  []event
+		activity []string
+	}{
+		{
+			name: "move",
+			bulk: func(svc *testServices, cardID, doneID string) (*BulkResult, error) {
+				return svc.cards.BulkMove(context.Background(), []string{cardID}, doneID)
+			},
+			history:  []event{{"created", "", "todo"}, {"moved", "todo", "done"}, {"moved", "done", "todo"}},
+			activity: []string{domain.TriggerCardMoved},
+		},
+		{
+			name: "archive",
+			bulk: func(svc *testServices, cardID, _ string) (*BulkResult, error) {
+				return svc.cards.BulkArchive(context.Background(), []string{cardID})
+			},
+			history:  []event{{"created", "", "todo"}, {"archived", "todo", ""}, {"restored", "", "todo"}},
+			activity: []string{domain.TriggerCardCreated},
+		},
+		{
+			name: "delete",
+			bulk: func(svc *testServices, cardID, _ string) (*BulkResult, error) {
+				return svc.cards.BulkDelete(context.Background(), []string{cardID})
+			},
+			history:  []event{{"created", "", "todo"}, {"deleted", "todo", ""}, {"restored", "", "todo"}},
+			activity: []string{domain.TriggerCardCreated},
+		},
+		{
+			name: "update",
+			bulk: func(svc *testServices, cardID, _ string) (*BulkResult, error) {
+				title := "Renamed"
+				return svc.cards.BulkUpdate(context.Background(), []string{cardID}, domain.CardUpdate{Title: &title})
+			},
+			history: []event{{"created", "", "todo"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc := newTestServices(t)
+			board, err := svc.boards.Create(ctx, "Undo")
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := svc.boards.GetWithData(ctx, board.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			todo, done := data.Columns[0].Column.ID, data.Columns[len(data.Columns)-1].Column.ID
+			card, err := svc.cards.Create(ctx, todo, "Card")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := tt.bulk(svc, card.ID, done); err != nil {
+				t.Fatal(err)
+			}
+
+			var activity []string
+			svc.feed.SubscribeCards(func(_ context.Context, a domain.CardActivity) {
+				if a.CardID == card.ID {
+					activity = append(activity, a.Kind)
+				}
+			})
+			if _, err := svc.cards.Undo(ctx); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(activity, tt.activity) {
+				t.Errorf("activity = %v, want %v", activity, tt.activity)
+			}
+
+			events, err := svc.cards.History(ctx, card.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			name := map[string]string{todo: "todo", done: "done"}
+			var got []event
+			for _, e := range events {
+				got = append(got, event{e.Kind, name[e.FromColumnID], name[e.ToColumnID]})
+			}
+			if !reflect.DeepEqual(got, tt.history) {
+				t.Errorf("history = %v, want %v", got, tt.history)
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,60 +13,335 @@ import (
 )
 
 type CardService struct {
-	cards   domain.CardRepository
-	columns domain.ColumnRepository
+	cards     domain.CardRepository
+	columns   domain.ColumnRepository
+	boards    domain.BoardRepository
+	templates domain.CardTemplateRepository
+	history   domain.HistoryRepository
+	people    domain.PersonRepository
+	fields    domain.CustomFieldRepository
+	workflows domain.WorkflowRepository
+	undo      domain.UndoRepository
+	activity  *ActivityFeed
+	tx        domain.Transactor
 }
 
-func NewCardService(cards domain.CardRepository, columns domain.ColumnRepository) *CardService {
-	return &CardService{cards: cards, columns: columns}
+func NewCardService(
+	cards domain.CardRepository,
+	columns domain.ColumnRepository,
+	boards domain.BoardRepository,
+	templates domain.CardTemplateRepository,
+	history domain.HistoryRepository,
+	people domain.PersonRepository,
+	fields domain.CustomFieldRepository,
+	workflows domain.WorkflowRepository,
+	undo domain.UndoRepository,
+	activity *ActivityFeed,
+	tx domain.Transactor,
+) *CardService {
+	return &CardService{
+		cards:     cards,
+		columns:   columns,
+		boards:    boards,
+		templates: templates,
+		history:   history,
+		people:    people,
+		fields:    fields,
+		workflows: workflows,
+		undo:      undo,
+		activity:  activity,
+		tx:        tx,
+	}
 }
 
 func (s *CardService) Create(ctx context.Context, columnID, title string) (*domain.Card, error) {
-	if title == "" {
-		return nil, fmt.Errorf("%w: card title cannot be empty", domain.ErrValidation)
+	card := &domain.Card{ColumnID: columnID, Title: title}
+	col, err := s.insert(ctx, card)
+	if err != nil {
+		return nil, err
 	}
+	s.activity.publishCreated(ctx, card.ID, col)
+	return card, nil
+}
 
-	if _, err := s.columns.GetByID(ctx, columnID); err != nil {
+// insert stores card at the end of its column without announcing it. It assigns the
+// ID, position and timestamps; an empty priority becomes the board's default.
+func (s *CardService) insert(ctx context.Context, card *domain.Card) (*domain.Column, error) {
+	v := domain.NewValidator()
+	v.Title("title", card.Title, domain.MaxCardTitleLength)
+	v.MaxLength("description", card.Description, domain.MaxDescriptionLength)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	col, err := s.columns.GetByID(ctx, card.ColumnID)
+	if err != nil {
 		return nil, fmt.Errorf("column not found: %w", err)
 	}
+	if card.Priority == "" {
+		board, err := s.boards.GetByID(ctx, col.BoardID)
+		if err != nil {
+			return nil, err
+		}
+		card.Priority = domain.DefaultPriority(board.Priorities)
+	}
 
-	maxPos, err := s.cards.MaxPosition(ctx, columnID)
+	maxPos, err := s.cards.MaxPosition(ctx, card.ColumnID)
 	if err != nil {
 		return nil, err
 	}
 
 	now := time.Now().UTC()
-	card := &domain.Card{
-		ID:        uuid.New().String(),
-		ColumnID:  columnID,
-		Title:     title,
-		Priority:  "medium",
-		Position:  maxPos + 1000,
-		CreatedAt: now,
-		UpdatedAt: now,
-	}
-
+	card.ID = uuid.New().String()
+	card.Position = maxPos + 1000
+	card.CreatedAt, card.UpdatedAt = now, now
 	if err := s.cards.Create(ctx, card); err != nil {
 		return nil, err
 	}
-	return card, nil
+	return col, nil
 }
 
 func (s *CardService) Update(ctx context.Context, id string, updates domain.CardUpdate) (*domain.Card, error) {
-	if updates.Title != nil && *updates.Title == "" {
-		return nil, fmt.Errorf("%w: card title cannot be empty", domain.ErrValidation)
+	v := domain.NewValidator()
+	if updates.Title != nil {
+		v.Title("title", *updates.Title, domain.MaxCardTitleLength)
+	}
+	if updates.Description != nil {
+		v.MaxLength("description", *updates.Description, domain.MaxDescriptionLength)
+	}
+	if updates.Priority != nil || (updates.Estimate != nil && *updates.Estimate != "") {
+		board, err := s.cardBoard(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if updates.Priority != nil {
+			v.Priority("priority", *updates.Priority, board.Priorities)
+		}
+		if updates.Estimate != nil && *updates.Estimate != "" {
+			scale := boardEstimateScale(board)
+			if n, ok := scale.Parse(*updates.Estimate); ok {
+				*updates.Estimate = domain.FormatEstimate(n)
+			} else {
+				v.Add("estimate", domain.CodeInvalidValue, "estimate is not on the board's "+scale.Kind+" scale")
+			}
+		}
+	}
+	if updates.StartDate != nil || updates.DueAt != nil {
+		card, err := s.cards.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		normalizeSchedule(v, card, &updates)
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 	return s.cards.Update(ctx, id, updates)
 }
 
+// cardBoard returns the board a card is on.
+func (s *CardService) cardBoard(ctx context.Context, cardID string) (*domain.Board, error) {
+	card, err := s.cards.GetByID(ctx, cardID)
+	if err != nil {
+		return nil, err
+	}
+	col, err := s.columns.GetByID(ctx, card.ColumnID)
+	if err != nil {
+		return nil, err
+	}
+	return s.boards.GetByID(ctx, col.BoardID)
+}
+
+// boardEstimateScale returns board's estimate scale, Fibonacci when it is unknown.
+func boardEstimateScale(board *domain.Board) domain.EstimateScale {
+	scale, ok := domain.EstimateScales[board.EstimateScale]
+	if !ok {
+		return domain.EstimateScales[domain.EstimateScaleFibonacci]
+	}
+	return scale
+}
+
+// normalizeSchedule validates the start/due dates in updates against the card's
+// current values and rewrites them into canonical stored form.
+// Start and due must both be all-day or both be timed, and start may not be after due.
+func normalizeSchedule(v *domain.Validator, card *domain.Card, updates *domain.CardUpdate) {
+	type date struct {
+		t      time.Time
+		allDay bool
+		set    bool
+	}
+	resolve := func(field string, current *time.Time, input *string) (date, bool) {
+		if input == nil {
+			if current == nil {
+				return date{}, true
+			}
+			return date{t: *current, allDay: card.AllDay, set: true}, true
+		}
+		if *input == "" {
+			return date{}, true
+		}
+		t, allDay, err := domain.ParseCardDate(*input)
+		if err != nil {
+			v.Add(field, domain.CodeInvalidFormat, field+" must be YYYY-MM-DD or an RFC3339 date-time")
+			return date{}, false
+		}
+		*input = domain.FormatCardDate(t, allDay)
+		return date{t: t, allDay: allDay, set: true}, true
+	}
+
+	start, okStart := resolve("start_date", card.StartDate, updates.StartDate)
+	due, okDue := resolve("due_at", card.DueAt, updates.DueAt)
+	if !okStart || !okDue || !start.set || !due.set {
+		return
+	}
+	if start.allDay != due.allDay {
+		v.Add("due_at", domain.CodeInvalidFormat, "start_date and due_at must both be dates or both be date-times")
+		return
+	}
+	if start.t.After(due.t) {
+		v.Add("start_date", domain.CodeOutOfOrder, "start_date must not be after due_at")
+	}
+}
+
 func (s *CardService) Delete(ctx context.Context, id string) error {
 	return s.cards.Delete(ctx, id)
 }
 
-func (s *CardService) Move(ctx context.Context, id, targetColumnID string, newPosition int) error {
-	return s.cards.Move(ctx, id, targetColumnID, newPosition)
+// Move moves a card within its board. Use MoveToBoard to cross boards.
+// It returns ErrTransitionNotAllowed when the board's workflow forbids the move,
+// unless override is set.
+func (s *CardService) Move(ctx context.Context, id, targetColumnID string, newPosition int, override bool) error {
+	card, from, to, err := s.moveEndpoints(ctx, id, targetColumnID)
+	if err != nil {
+		return err
+	}
+	v := domain.NewValidator()
+	v.ColumnInBoard("target_column_id", to, from.BoardID)
+	if err := v.Err(); err != nil {
+		return err
+	}
+	if !override {
+		if err := s.checkTransition(ctx, from, to); err != nil {
+			return err
+		}
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.cards.Move(ctx, id, to.ID, newPosition); err != nil {
+			return err
+		}
+		return recordMove(ctx, s.history, card.ID, from, to, time.Now().UTC())
+	})
+	if err != nil {
+		return err
+	}
+	s.activity.publishMoved(ctx, card.ID, from, to)
+	return nil
+}
+
+// MoveToBoard moves a card to the end of a column on another board.
+// targetColumnID must belong to targetBoardID, and targetBoardID must not be the
+// card's own board: moves within a board go through Move and its workflow.
+func (s *CardService) MoveToBoard(ctx context.Context, id, targetBoardID, targetColumnID string) (*domain.Card, error) {
+	card, from, to, err := s.moveEndpoints(ctx, id, targetColumnID)
+	if err != nil {
+		return nil, err
+	}
+	v := domain.NewValidator()
+	if targetBoardID == from.BoardID {
+		v.Add("target_board_id", domain.CodeInvalidValue, "target_board_id must be another board; use MoveCard within a board")
+	}
+	v.ColumnInBoard("target_column_id", to, targetBoardID)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		maxPos, err := s.cards.MaxPosition(ctx, to.ID)
+		if err != nil {
+			return err
+		}
+		if err := s.cards.Move(ctx, id, to.ID, maxPos+1000); err != nil {
+			return err
+		}
+		priority, err := remapPriority(ctx, s.boards, card.Priority, from.BoardID, to.BoardID)
+		if err != nil {
+			return err
+		}
+		if priority != card.Priority {
+			if _, err := s.cards.Update(ctx, id, domain.CardUpdate{Priority: &priority}); err != nil {
+				return err
+			}
+		}
+		// Custom fields are per board: carry values over to matching fields on the target.
+		values, err := remapFields(ctx, s.fields, card.CustomFields, from.BoardID, to.BoardID)
+		if err != nil {
+			return err
+		}
+		for fieldID := range card.CustomFields {
+			if err := s.fields.SetValue(ctx, id, fieldID, nil); err != nil {
+				return err
+			}
+		}
+		for fieldID, value := range values {
+			if err := s.fields.SetValue(ctx, id, fieldID, value); err != nil {
+				return err
+			}
+		}
+		return recordMove(ctx, s.history, card.ID, from, to, time.Now().UTC())
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.activity.publishMoved(ctx, card.ID, from, to)
+	return s.cards.GetByID(ctx, id)
+}
+
+// moveEndpoints loads a card with its current and target columns.
+func (s *CardService) moveEndpoints(ctx context.Context, id, targetColumnID string) (*domain.Card, *domain.Column, *domain.Column, error) {
+	card, err := s.cards.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	from, err := s.columns.GetByID(ctx, card.ColumnID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	to, err := s.columns.GetByID(ctx, targetColumnID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return card, from, to, nil
+}
+
+// Search finds cards by title or description. A non-empty assigneeID keeps only that
+// person's cards, or unassigned cards for domain.AssigneeNone.
+func (s *CardService) Search(ctx context.Context, boardID, query, assigneeID string) ([]domain.Card, error) {
+	return s.cards.Search(ctx, boardID, query, assigneeID)
 }
 
-func (s *CardService) Search(ctx context.Context, boardID, query string) ([]domain.Card, error) {
-	return s.cards.Search(ctx, boardID, query)
+// SetAssignees replaces a card's assignees. Duplicate IDs are ignored.
+func (s *CardService) SetAssignees(ctx context.Context, cardID string, personIDs []string) (*domain.Card, error) {
+	v := domain.NewValidator()
+	ids := make([]string, 0, len(personIDs))
+	for _, id := range personIDs {
+		if slices.Contains(ids, id) {
+			continue
+		}
+		if _, err := s.people.GetByID(ctx, id); errors.Is(err, domain.ErrNotFound) {
+			v.Add("person_ids", domain.CodeInvalidValue, fmt.Sprintf("person %s does not exist", id))
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := s.cards.SetAssignees(ctx, cardID, ids); err != nil {
+		return nil, err
+	}
+	return s.cards.GetByID(ctx, cardID)
 }
@@ -0,0 +1,125 @@
+package application
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+func TestNormalizeSchedule(t *testing.T) {
+	day := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	instant := time.Date(2026, 3, 5, 10, 0, 0, 0, time.UTC)
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name      string
+		card      domain.Card
+		start     *string
+		due       *string
+		wantStart *string
+		wantDue   *string
+		wantErrs  []domain.FieldError
+	}{
+		{name: "no dates"},
+		{
+			name:  "all-day range",
+			start: str("2026-03-01"), due: str("2026-03-05"),
+			wantStart: str("2026-03-01"), wantDue: str("2026-03-05"),
+		},
+		{
+			name:  "timed dates become UTC",
+			start: str("2026-03-05T09:00:00+08:00"), due: str("2026-03-05T10:00:00+08:00"),
+			wantStart: str("2026-03-05T01:00:00Z"), wantDue: str("2026-03-05T02:00:00Z"),
+		},
+		{
+			name:  "same day is in order",
+			start: str("2026-03-05"), due: str("2026-03-05"),
+			wantStart: str("2026-03-05"), wantDue: str("2026-03-05"),
+		},
+		{
+			name: "invalid due",
+			due:  str("next week"), wantDue: str("next week"),
+			wantErrs: []domain.FieldError{{Field: "due_at", Code: domain.CodeInvalidFormat}},
+		},
+		{
+			name:  "both invalid",
+			start: str("03/01/2026"), due: str("2026-02-30"),
+			wantStart: str("03/01/2026"), wantDue: str("2026-02-30"),
+			wantErrs: []domain.FieldError{
+				{Field: "start_date", Code: domain.CodeInvalidFormat},
+				{Field: "due_at", Code: domain.CodeInvalidFormat},
+			},
+		},
+		{
+			name:  "mixed kinds",
+			start: str("2026-03-01"), due: str("2026-03-05T10:00:00Z"),
+			wantStart: str("2026-03-01"), wantDue: str("2026-03-05T10:00:00Z"),
+			wantErrs: []domain.FieldError{{Field: "due_at", Code: domain.CodeInvalidFormat}},
+		},
+		{
+			name:  "start after due",
+			start: str("2026-03-06"), due: str("2026-03-05"),
+			wantStart: str("2026-03-06"), wantDue: str("2026-03-05"),
+			wantErrs: []domain.FieldError{{Field: "start_date", Code: domain.CodeOutOfOrder}},
+		},
+		{
+			name:  "start after the current due date",
+			card:  domain.Card{DueAt: &day, AllDay: true},
+			start: str("2026-03-10"), wantStart: str("2026-03-10"),
+			wantErrs: []domain.FieldError{{Field: "start_date", Code: domain.CodeOutOfOrder}},
+		},
+		{
+			name:  "clearing the due date",
+			card:  domain.Card{DueAt: &day, AllDay: true},
+			start: str("2026-03-10"), due: str(""),
+			wantStart: str("2026-03-10"), wantDue: str(""),
+		},
+		{
+			name:  "all-day start against a timed due date",
+			card:  domain.Card{DueAt: &instant},
+			start: str("2026-03-01"), wantStart: str("2026-03-01"),
+			wantErrs: []domain.FieldError{{Field: "due_at", Code: domain.CodeInvalidFormat}},
+		},
+		{
+			name:  "timed start before a timed due date",
+			card:  domain.Card{DueAt: &instant},
+			start: str("2026-03-05T09:59:00Z"), wantStart: str("2026-03-05T09:59:00Z"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updates := domain.CardUpdate{StartDate: tt.start, DueAt: tt.due}
+			v := domain.NewValidator()
+			normalizeSchedule(v, &tt.card, &updates)
+
+			if !reflect.DeepEqual(updates.StartDate, tt.wantStart) {
+				t.Errorf("start_date = %v, want %v", deref(updates.StartDate), deref(tt.wantStart))
+			}
+			if !reflect.DeepEqual(updates.DueAt, tt.wantDue) {
+				t.Errorf("due_at = %v, want %v", deref(updates.DueAt), deref(tt.wantDue))
+			}
+
+			var got []domain.FieldError
+			var verr *domain.ValidationError
+			if errors.As(v.Err(), &verr) {
+				for _, f := range verr.Fields {
+					got = append(got, domain.FieldError{Field: f.Field, Code: f.Code})
+				}
+			}
+			if !reflect.DeepEqual(got, tt.wantErrs) {
+				t.Errorf("errors = %+v, want %+v", got, tt.wantErrs)
+			}
+		})
+	}
+}
+
+// deref returns *s, or "<nil>" for a nil pointer.
+func deref(s *string) string {
+	if s == nil {
+		return "<nil>"
+	}
+	return *s
+}
@@ -0,0 +1,186 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// placeholderPattern matches {{name}} placeholders in card templates.
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_]+)\s*\}\}`)
+
+// expandTemplate replaces {{name}} placeholders with vars[name]; unknown names are left as-is.
+func expandTemplate(s string, vars map[string]string) string {
+	return placeholderPattern.ReplaceAllStringFunc(s, func(m string) string {
+		name := placeholderPattern.FindStringSubmatch(m)[1]
+		if v, ok := vars[name]; ok {
+			return v
+		}
+		return m
+	})
+}
+
+func validateCardTemplate(in domain.CardTemplateInput, levels []domain.PriorityLevel, fields []domain.CustomField) error {
+	v := domain.NewValidator()
+	v.Title("name", in.Name, domain.MaxCardTitleLength)
+	v.Title("title_pattern", in.TitlePattern, domain.MaxCardTitleLength)
+	v.MaxLength("description", in.Description, domain.MaxDescriptionLength)
+	if in.Priority != "" {
+		v.Priority("priority", in.Priority, levels)
+	}
+	for _, l := range in.Labels {
+		if _, _, ok := quickAddLabel(fields, l); !ok {
+			v.Add("labels", domain.CodeInvalidValue, fmt.Sprintf("%q is not an option of a multi-select field", l))
+		}
+	}
+	for _, item := range in.Checklist {
+		v.Title("checklist", item, domain.MaxCardTitleLength)
+	}
+	tpl := domain.CardTemplate{Description: in.Description, Checklist: in.Checklist}
+	v.MaxLength("description", tpl.ChecklistDescription(), domain.MaxDescriptionLength)
+	return v.Err()
+}
+
+func (s *CardService) ListTemplates(ctx context.Context, boardID string) ([]domain.CardTemplate, error) {
+	return s.templates.GetByBoardID(ctx, boardID)
+}
+
+func (s *CardService) CreateTemplate(ctx context.Context, boardID string, in domain.CardTemplateInput) (*domain.CardTemplate, error) {
+	board, err := s.boards.GetByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := s.fields.GetByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCardTemplate(in, board.Priorities, fields); err != nil {
+		return nil, err
+	}
+	if in.Priority == "" {
+		in.Priority = domain.DefaultPriority(board.Priorities)
+	}
+
+	tpl := &domain.CardTemplate{
+		ID:           uuid.New().String(),
+		BoardID:      boardID,
+		Name:         in.Name,
+		TitlePattern: in.TitlePattern,
+		Description:  in.Description,
+		Priority:     in.Priority,
+		Labels:       in.Labels,
+		Checklist:    in.Checklist,
+		CreatedAt:    time.Now().UTC(),
+	}
+	if err := s.templates.Create(ctx, tpl); err != nil {
+		return nil, err
+	}
+	return tpl, nil
+}
+
+func (s *CardService) UpdateTemplate(ctx context.Context, id string, in domain.CardTemplateInput) (*domain.CardTemplate, error) {
+	tpl, err := s.templates.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	board, err := s.boards.GetByID(ctx, tpl.BoardID)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := s.fields.GetByBoardID(ctx, tpl.BoardID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCardTemplate(in, board.Priorities, fields); err != nil {
+		return nil, err
+	}
+	tpl.Name, tpl.TitlePattern, tpl.Description = in.Name, in.TitlePattern, in.Description
+	tpl.Labels, tpl.Checklist = in.Labels, in.Checklist
+	if in.Priority != "" {
+		tpl.Priority = in.Priority
+	}
+	if err := s.templates.Update(ctx, tpl); err != nil {
+		return nil, err
+	}
+	return tpl, nil
+}
+
+func (s *CardService) DeleteTemplate(ctx context.Context, id string) error {
+	return s.templates.Delete(ctx, id)
+}
+
+// CreateFromTemplate creates a card in columnID from a template of the same board.
+// Placeholders are filled from vars, plus the built-ins {{date}} (today, local time)
+// and {{counter}} (a per-template sequence); caller-supplied vars take precedence.
+// The counter only advances when the card is created.
+func (s *CardService) CreateFromTemplate(ctx context.Context, columnID, templateID string, vars map[string]string) (*domain.Card, error) {
+	tpl, err := s.templates.GetByID(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	col, err := s.columns.GetByID(ctx, columnID)
+	if err != nil {
+		return nil, err
+	}
+	v := domain.NewValidator()
+	v.ColumnInBoard("column_id", col, tpl.BoardID)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	card := &domain.Card{ColumnID: columnID}
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		counter, err := s.templates.NextCounter(ctx, templateID)
+		if err != nil {
+			return err
+		}
+		values := map[string]string{
+			"date":    time.Now().Format(time.DateOnly),
+			"counter": strconv.Itoa(counter),
+		}
+		maps.Copy(values, vars)
+		_, err = s.insertFromTemplate(ctx, tpl, card, values)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.activity.publishCreated(ctx, card.ID, col)
+	return s.cards.GetByID(ctx, card.ID)
+}
+
+// insertFromTemplate fills card's title, description and priority from tpl with its
+// placeholders replaced by values, stores it like insert and sets the template's
+// labels on it. Labels that are no longer options on the board are skipped.
+func (s *CardService) insertFromTemplate(ctx context.Context, tpl *domain.CardTemplate, card *domain.Card, values map[string]string) (*domain.Column, error) {
+	card.Title = expandTemplate(tpl.TitlePattern, values)
+	card.Description = expandTemplate(tpl.ChecklistDescription(), values)
+	card.Priority = tpl.Priority
+	col, err := s.insert(ctx, card)
+	if err != nil {
+		return nil, err
+	}
+	if len(tpl.Labels) == 0 {
+		return col, nil
+	}
+
+	fields, err := s.fields.GetByBoardID(ctx, tpl.BoardID)
+	if err != nil {
+		return nil, err
+	}
+	labels := map[string][]any{}
+	for _, l := range tpl.Labels {
+		if fieldID, option, ok := quickAddLabel(fields, l); ok && !slices.Contains(labels[fieldID], any(option)) {
+			labels[fieldID] = append(labels[fieldID], option)
+		}
+	}
+	return col, s.setLabels(ctx, card.ID, fields, labels)
+}
@@ -0,0 +1,69 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"kanban-app-playground/internal/domain"
+)
+
+func TestCreateCardFromTemplate(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestServices(t)
+	board, err := svc.boards.Create(ctx, "Templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := svc.boards.GetWithData(ctx, board.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	column := data.Columns[0].Column.ID
+	field, err := svc.fields.Create(ctx, board.ID, domain.CustomFieldInput{
+		Name: "Labels", Type: domain.FieldMultiSelect, Options: []string{"bug", "ui"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := svc.cards.CreateTemplate(ctx, board.ID, domain.CardTemplateInput{
+		Name: "Unknown label", TitlePattern: "Card", Labels: []string{"backend"},
+	}); !errors.Is(err, domain.ErrValidation) {
+		t.Errorf("unknown label: err = %v, want a validation error", err)
+	}
+
+	tpl, err := svc.cards.CreateTemplate(ctx, board.ID, domain.CardTemplateInput{
+		Name:         "Bug report",
+		TitlePattern: "Bug #{{counter}}: {{summary}}",
+		Description:  "Steps to reproduce",
+		Labels:       []string{"BUG", "ui"},
+		Checklist:    []string{"Reproduce", "Fix in {{counter}}"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A title that expands past the limit fails without using up a counter value.
+	if _, err := svc.cards.CreateFromTemplate(ctx, column, tpl.ID, map[string]string{
+		"summary": strings.Repeat("x", domain.MaxCardTitleLength),
+	}); !errors.Is(err, domain.ErrValidation) {
+		t.Fatalf("long title: err = %v, want a validation error", err)
+	}
+
+	card, err := svc.cards.CreateFromTemplate(ctx, column, tpl.ID, map[string]string{"summary": "crash"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Bug #1: crash"; card.Title != want {
+		t.Errorf("title = %q, want %q", card.Title, want)
+	}
+	if want := "Steps to reproduce\n\n- [ ] Reproduce\n- [ ] Fix in 1"; card.Description != want {
+		t.Errorf("description = %q, want %q", card.Description, want)
+	}
+	if want := []any{"bug", "ui"}; !reflect.DeepEqual(card.CustomFields[field.ID], want) {
+		t.Errorf("labels = %v, want %v", card.CustomFields[field.ID], want)
+	}
+}
@@ -3,6 +3,8 @@ package application
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,17 +13,39 @@ import (
 )
 
 type ColumnService struct {
-	columns domain.ColumnRepository
-	cards   domain.CardRepository
+	columns   domain.ColumnRepository
+	cards     domain.CardRepository
+	boards    domain.BoardRepository
+	history   domain.HistoryRepository
+	workflows domain.WorkflowRepository
+	activity  *ActivityFeed
+	tx        domain.Transactor
 }
 
-func NewColumnService(columns domain.ColumnRepository, cards domain.CardRepository) *ColumnService {
-	return &ColumnService{columns: columns, cards: cards}
+func NewColumnService(
+	columns domain.ColumnRepository,
+	cards domain.CardRepository,
+	boards domain.BoardRepository,
+	history domain.HistoryRepository,
+	workflows domain.WorkflowRepository,
+	activity *ActivityFeed,
+	tx domain.Transactor,
+) *ColumnService {
+	return &ColumnService{
+		columns: columns, cards: cards, boards: boards, history: history,
+		workflows: workflows, activity: activity, tx: tx,
+	}
 }
 
 func (s *ColumnService) Create(ctx context.Context, boardID, title string) (*domain.Column, error) {
-	if title == "" {
-		return nil, fmt.Errorf("%w: column title cannot be empty", domain.ErrValidation)
+	v := domain.NewValidator()
+	v.Title("title", title, domain.MaxColumnTitleLength)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.boards.GetByID(ctx, boardID); err != nil {
+		return nil, err
 	}
 
 	maxPos, err := s.columns.MaxPosition(ctx, boardID)
@@ -33,6 +57,7 @@ func (s *ColumnService) Create(ctx context.Context, boardID, title string) (*dom
 		ID:        uuid.New().String(),
 		BoardID:   boardID,
 		Title:     title,
+		Kind:      domain.ColumnKindActive,
 		Position:  maxPos + 1000,
 		CreatedAt: time.Now().UTC(),
 	}
@@ -44,8 +69,10 @@ func (s *ColumnService) Create(ctx context.Context, boardID, title string) (*dom
 }
 
 func (s *ColumnService) Update(ctx context.Context, id, title string) (*domain.Column, error) {
-	if title == "" {
-		return nil, fmt.Errorf("%w: column title cannot be empty", domain.ErrValidation)
+	v := domain.NewValidator()
+	v.Title("title", title, domain.MaxColumnTitleLength)
+	if err := v.Err(); err != nil {
+		return nil, err
 	}
 
 	col, err := s.columns.GetByID(ctx, id)
@@ -60,6 +87,54 @@ func (s *ColumnService) Update(ctx context.Context, id, title string) (*domain.C
 	return col, nil
 }
 
+// SetKind marks a column as backlog, active or done. Cards already in the column
+// are stamped as completed, or reopened, to match. A board flow column that no
+// longer has the matching kind is unset, so flow metrics fall back to the defaults.
+func (s *ColumnService) SetKind(ctx context.Context, id, kind string) (*domain.Column, error) {
+	v := domain.NewValidator()
+	if !slices.Contains(domain.ColumnKinds, kind) {
+		v.Add("kind", domain.CodeInvalidValue, "kind must be one of "+strings.Join(domain.ColumnKinds, ", "))
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	var col *domain.Column
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if col, err = s.columns.GetByID(ctx, id); err != nil {
+			return err
+		}
+		if err := s.columns.SetKind(ctx, id, kind); err != nil {
+			return err
+		}
+		col.Kind = kind
+		if err := s.cards.SyncCompleted(ctx, id, time.Now().UTC()); err != nil {
+			return err
+		}
+
+		board, err := s.boards.GetByID(ctx, col.BoardID)
+		if err != nil {
+			return err
+		}
+		start, done := board.StartColumnID, board.DoneColumnID
+		if start == id && kind != domain.ColumnKindActive {
+			start = ""
+		}
+		if done == id && kind != domain.ColumnKindDone {
+			done = ""
+		}
+		if start == board.StartColumnID && done == board.DoneColumnID {
+			return nil
+		}
+		return s.boards.SetFlowColumns(ctx, board.ID, start, done)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return col, nil
+}
+
 // Delete removes a column. If moveCardsTo is non-empty, cards are moved first.
 // Returns ErrLastColumn if it's the only column in the board.
 func (s *ColumnService) Delete(ctx context.Context, id, moveCardsTo string) error {
@@ -76,13 +151,39 @@ func (s *ColumnService) Delete(ctx context.Context, id, moveCardsTo string) erro
 		return domain.ErrLastColumn
 	}
 
-	if moveCardsTo != "" {
+	if moveCardsTo == "" {
+		return s.columns.Delete(ctx, id)
+	}
+
+	target, err := s.columns.GetByID(ctx, moveCardsTo)
+	if err != nil {
+		return err
+	}
+	v := domain.NewValidator()
+	v.ColumnInBoard("move_cards_to", target, col.BoardID)
+	if target.ID == id {
+		v.Add("move_cards_to", domain.CodeInvalidValue, "move_cards_to must be a different column")
+	}
+	if err := v.Err(); err != nil {
+		return err
+	}
+
+	return s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		cards, err := s.cards.GetByColumnID(ctx, id)
+		if err != nil {
+			return err
+		}
 		if err := s.cards.MoveAllToColumn(ctx, id, moveCardsTo); err != nil {
 			return fmt.Errorf("move cards: %w", err)
 		}
-	}
-
-	return s.columns.Delete(ctx, id)
+		now := time.Now().UTC()
+		for _, c := range cards {
+			if err := recordMove(ctx, s.history, c.ID, col, target, now); err != nil {
+				return err
+			}
+		}
+		return s.columns.Delete(ctx, id)
+	})
 }
 
 func (s *ColumnService) Move(ctx context.Context, id string, newPosition int) error {
@@ -0,0 +1,265 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// fieldTypes lists the custom field types in the order they are offered.
+var fieldTypes = []string{
+	domain.FieldText, domain.FieldNumber, domain.FieldDate, domain.FieldSelect,
+	domain.FieldMultiSelect, domain.FieldCheckbox, domain.FieldURL,
+}
+
+// CustomFieldService manages board custom field definitions and card values.
+type CustomFieldService struct {
+	fields  domain.CustomFieldRepository
+	boards  domain.BoardRepository
+	columns domain.ColumnRepository
+	cards   domain.CardRepository
+	tx      domain.Transactor
+}
+
+func NewCustomFieldService(
+	fields domain.CustomFieldRepository,
+	boards domain.BoardRepository,
+	columns domain.ColumnRepository,
+	cards domain.CardRepository,
+	tx domain.Transactor,
+) *CustomFieldService {
+	return &CustomFieldService{fields: fields, boards: boards, columns: columns, cards: cards, tx: tx}
+}
+
+func (s *CustomFieldService) List(ctx context.Context, boardID string) ([]domain.CustomField, error) {
+	if _, err := s.boards.GetByID(ctx, boardID); err != nil {
+		return nil, err
+	}
+	return s.fields.GetByBoardID(ctx, boardID)
+}
+
+// validateField checks in against the board's other fields and returns it
+// with its name and options trimmed. fieldType is the type being saved.
+func validateField(in domain.CustomFieldInput, fieldType string, others []domain.CustomField) (domain.CustomFieldInput, error) {
+	in.Name = strings.TrimSpace(in.Name)
+	options := make([]string, 0, len(in.Options))
+	for _, o := range in.Options {
+		options = append(options, strings.TrimSpace(o))
+	}
+	in.Options = options
+
+	v := domain.NewValidator()
+	v.Title("name", in.Name, domain.MaxFieldNameLength)
+	for _, f := range others {
+		if strings.EqualFold(f.Name, in.Name) {
+			v.Add("name", domain.CodeInvalidValue, "a field named "+f.Name+" already exists on this board")
+			break
+		}
+	}
+	if !slices.Contains(fieldTypes, fieldType) {
+		v.Add("type", domain.CodeInvalidValue, "type must be one of "+strings.Join(fieldTypes, ", "))
+	}
+	isSelect := fieldType == domain.FieldSelect || fieldType == domain.FieldMultiSelect
+	switch {
+	case isSelect && len(options) == 0:
+		v.Add("options", domain.CodeRequired, "select fields need at least one option")
+	case !isSelect && len(options) > 0:
+		v.Add("options", domain.CodeInvalidValue, "only select fields have options")
+	case len(options) > domain.MaxFieldOptions:
+		v.Add("options", domain.CodeInvalidValue, fmt.Sprintf("a field can have at most %d options", domain.MaxFieldOptions))
+	}
+	// Report only the first bad option.
+	for i, o := range options {
+		if o == "" {
+			v.Add("options", domain.CodeRequired, "options must not be empty")
+			break
+		}
+		if utf8.RuneCountInString(o) > domain.MaxFieldOptionLength {
+			v.Add("options", domain.CodeTooLong, fmt.Sprintf("options must be at most %d characters", domain.MaxFieldOptionLength))
+			break
+		}
+		if slices.Contains(options[:i], o) {
+			v.Add("options", domain.CodeInvalidValue, "option "+o+" is listed twice")
+			break
+		}
+	}
+	return in, v.Err()
+}
+
+// Create adds a custom field at the end of the board's field list.
+func (s *CustomFieldService) Create(ctx context.Context, boardID string, in domain.CustomFieldInput) (*domain.CustomField, error) {
+	existing, err := s.List(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	if in, err = validateField(in, in.Type, existing); err != nil {
+		return nil, err
+	}
+
+	f := &domain.CustomField{
+		ID:        uuid.New().String(),
+		BoardID:   boardID,
+		Name:      in.Name,
+		Type:      in.Type,
+		Options:   in.Options,
+		CreatedAt: time.Now().UTC(),
+	}
+	if n := len(existing); n > 0 {
+		f.Position = existing[n-1].Position + 1
+	}
+	if err := s.fields.Create(ctx, f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Update renames a field or changes its options. The type is fixed; values that
+// are no longer valid (a removed option) are dropped from the cards holding them.
+func (s *CustomFieldService) Update(ctx context.Context, id string, in domain.CustomFieldInput) (*domain.CustomField, error) {
+	f, err := s.fields.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.fields.GetByBoardID(ctx, f.BoardID)
+	if err != nil {
+		return nil, err
+	}
+	others := slices.DeleteFunc(existing, func(o domain.CustomField) bool { return o.ID == id })
+	if in, err = validateField(in, f.Type, others); err != nil {
+		return nil, err
+	}
+	if in.Type != "" && in.Type != f.Type {
+		v := domain.NewValidator()
+		v.Add("type", domain.CodeInvalidValue, "a field's type cannot be changed")
+		return nil, v.Err()
+	}
+
+	f.Name, f.Options = in.Name, in.Options
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.fields.Update(ctx, f); err != nil {
+			return err
+		}
+		values, err := s.fields.Values(ctx, id)
+		if err != nil {
+			return err
+		}
+		for cardID, value := range values {
+			if kept := convertFieldValue(f, value); !fieldValueEqual(kept, value) {
+				if err := s.fields.SetValue(ctx, cardID, id, kept); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (s *CustomFieldService) Delete(ctx context.Context, id string) error {
+	return s.fields.Delete(ctx, id)
+}
+
+// SetValue sets a card's value for a field on the card's board; nil or an
+// empty value clears it.
+func (s *CustomFieldService) SetValue(ctx context.Context, cardID, fieldID string, value any) (*domain.Card, error) {
+	card, err := s.cards.GetByID(ctx, cardID)
+	if err != nil {
+		return nil, err
+	}
+	col, err := s.columns.GetByID(ctx, card.ColumnID)
+	if err != nil {
+		return nil, err
+	}
+	f, err := s.fields.GetByID(ctx, fieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	v := domain.NewValidator()
+	if f.BoardID != col.BoardID {
+		v.Add("field_id", domain.CodeWrongBoard, "field_id must belong to the card's board")
+	}
+	normalized, ok, msg := f.NormalizeValue(value)
+	if !ok {
+		v.Add("value", domain.CodeInvalidValue, msg)
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := s.fields.SetValue(ctx, cardID, fieldID, normalized); err != nil {
+		return nil, err
+	}
+	return s.cards.GetByID(ctx, cardID)
+}
+
+// convertFieldValue fits a stored value to field f, keeping what still applies:
+// multi-select values lose options f no longer has, anything else invalid becomes nil.
+func convertFieldValue(f *domain.CustomField, value any) any {
+	if list, ok := value.([]any); ok && f.Type == domain.FieldMultiSelect {
+		value = slices.DeleteFunc(slices.Clone(list), func(o any) bool {
+			s, _ := o.(string)
+			return !slices.Contains(f.Options, s)
+		})
+	}
+	normalized, ok, _ := f.NormalizeValue(value)
+	if !ok {
+		return nil
+	}
+	return normalized
+}
+
+// fieldValueEqual compares two JSON-decoded field values.
+func fieldValueEqual(a, b any) bool {
+	la, okA := a.([]any)
+	lb, okB := b.([]any)
+	if okA || okB {
+		return okA && okB && slices.Equal(la, lb)
+	}
+	return a == b
+}
+
+// remapFields converts custom field values from fromBoardID's fields to the
+// same-named fields of the same type on toBoardID. Values without a matching
+// field, or no longer valid for it, are dropped.
+func remapFields(ctx context.Context, fields domain.CustomFieldRepository, values map[string]any, fromBoardID, toBoardID string) (map[string]any, error) {
+	if fromBoardID == toBoardID || len(values) == 0 {
+		return values, nil
+	}
+	from, err := fields.GetByBoardID(ctx, fromBoardID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := fields.GetByBoardID(ctx, toBoardID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]any)
+	for _, src := range from {
+		value, ok := values[src.ID]
+		if !ok {
+			continue
+		}
+		i := slices.IndexFunc(to, func(f domain.CustomField) bool {
+			return f.Type == src.Type && strings.EqualFold(f.Name, src.Name)
+		})
+		if i < 0 {
+			continue
+		}
+		if v := convertFieldValue(&to[i], value); v != nil {
+			out[to[i].ID] = v
+		}
+	}
+	return out, nil
+}
@@ -3,13 +3,35 @@ package application
 import "kanban-app-playground/internal/domain"
 
 // BoardData is the query response for a full board with columns and cards.
+// EstimateTotal sums the estimates of every card in Columns.
 type BoardData struct {
-	Board   domain.Board     `json:"board"`
-	Columns []ColumnWithCards `json:"columns"`
+	Board         domain.Board      `json:"board"`
+	Columns       []ColumnWithCards `json:"columns"`
+	EstimateTotal float64           `json:"estimate_total"`
 }
 
 // ColumnWithCards pairs a column with its cards for API responses.
+// EstimateTotal sums the estimates of Cards; unestimated cards count as zero.
 type ColumnWithCards struct {
-	Column domain.Column `json:"column"`
-	Cards  []domain.Card `json:"cards"`
+	Column        domain.Column `json:"column"`
+	Cards         []domain.Card `json:"cards"`
+	EstimateTotal float64       `json:"estimate_total"`
+}
+
+func newColumnWithCards(col domain.Column, cards []domain.Card) ColumnWithCards {
+	cwc := ColumnWithCards{Column: col, Cards: cards}
+	for _, c := range cards {
+		if c.Estimate != nil {
+			cwc.EstimateTotal += *c.Estimate
+		}
+	}
+	return cwc
+}
+
+func newBoardData(board domain.Board, cols []ColumnWithCards) *BoardData {
+	data := &BoardData{Board: board, Columns: cols}
+	for _, col := range cols {
+		data.EstimateTotal += col.EstimateTotal
+	}
+	return data
 }
@@ -0,0 +1,311 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// copySuffix is appended to the titles of duplicated columns.
+const copySuffix = " (副本)"
+
+// copyCard returns a fresh copy of c with a new ID, placed in columnID at position.
+func copyCard(c domain.Card, columnID string, position int, now time.Time) domain.Card {
+	c.ID = uuid.New().String()
+	c.ColumnID = columnID
+	c.Position = position
+	c.ArchivedAt, c.CompletedAt = nil, nil
+	c.CreatedAt, c.UpdatedAt = now, now
+	return c
+}
+
+// positionAfter returns a position between positions[i] and the next entry,
+// or -1 when there is no room between them. positions must be ascending.
+func positionAfter(positions []int, i int) int {
+	if i == len(positions)-1 {
+		return positions[i] + 1000
+	}
+	if gap := positions[i+1] - positions[i]; gap > 1 {
+		return positions[i] + gap/2
+	}
+	return -1
+}
+
+// Duplicate copies a board with all of its columns and custom fields and, optionally, its cards.
+// Relative column and card order is preserved; the copy is written atomically.
+func (s *BoardService) Duplicate(ctx context.Context, boardID, newTitle string, includeCards bool) (*domain.Board, error) {
+	v := domain.NewValidator()
+	v.Title("title", newTitle, domain.MaxBoardTitleLength)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	var board *domain.Board
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		src, err := s.GetWithData(ctx, boardID)
+		if err != nil {
+			return err
+		}
+		board = &domain.Board{
+			ID: uuid.New().String(), Title: newTitle, EstimateScale: src.Board.EstimateScale,
+			Priorities: src.Board.Priorities, CreatedAt: now, UpdatedAt: now,
+		}
+		if err := s.boards.Create(ctx, board); err != nil {
+			return fmt.Errorf("create board: %w", err)
+		}
+		fieldIDs, err := s.copyFields(ctx, boardID, board.ID, now)
+		if err != nil {
+			return err
+		}
+		colIDs := make(map[string]string, len(src.Columns))
+		for _, cwc := range src.Columns {
+			col := cwc.Column
+			col.ID, col.BoardID, col.CreatedAt = uuid.New().String(), board.ID, now
+			if err := s.columns.Create(ctx, &col); err != nil {
+				return fmt.Errorf("copy column: %w", err)
+			}
+			colIDs[cwc.Column.ID] = col.ID
+			if cwc.Column.ID == src.Board.StartColumnID {
+				board.StartColumnID = col.ID
+			}
+			if cwc.Column.ID == src.Board.DoneColumnID {
+				board.DoneColumnID = col.ID
+			}
+			if !includeCards {
+				continue
+			}
+			for _, c := range cwc.Cards {
+				card := copyCard(c, col.ID, c.Position, now)
+				card.CustomFields = make(map[string]any, len(c.CustomFields))
+				for id, value := range c.CustomFields {
+					card.CustomFields[fieldIDs[id]] = value
+				}
+				if err := s.cards.Create(ctx, &card); err != nil {
+					return fmt.Errorf("copy card: %w", err)
+				}
+			}
+		}
+		if err := s.copyWorkflow(ctx, boardID, board.ID, colIDs); err != nil {
+			return err
+		}
+		if board.StartColumnID == "" && board.DoneColumnID == "" {
+			return nil
+		}
+		return s.boards.SetFlowColumns(ctx, board.ID, board.StartColumnID, board.DoneColumnID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.activity.publishBoard(ctx, domain.BoardCreated, board.ID)
+	return board, nil
+}
+
+// copyWorkflow copies a board's transition rules to another board, translating
+// column IDs through colIDs.
+func (s *BoardService) copyWorkflow(ctx context.Context, fromBoardID, toBoardID string, colIDs map[string]string) error {
+	src, err := s.workflows.GetByBoardID(ctx, fromBoardID)
+	if err != nil {
+		return err
+	}
+	if len(src.Transitions) == 0 {
+		return nil
+	}
+	w := &domain.Workflow{BoardID: toBoardID, Transitions: make(map[string][]string, len(src.Transitions))}
+	for from, targets := range src.Transitions {
+		for _, to := range targets {
+			w.Transitions[colIDs[from]] = append(w.Transitions[colIDs[from]], colIDs[to])
+		}
+	}
+	return s.workflows.Replace(ctx, w)
+}
+
+// copyFields copies the custom field definitions of one board to another and
+// returns the new field ID for each old one.
+func (s *BoardService) copyFields(ctx context.Context, fromBoardID, toBoardID string, now time.Time) (map[string]string, error) {
+	fields, err := s.fields.GetByBoardID(ctx, fromBoardID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]string, len(fields))
+	for _, f := range fields {
+		oldID := f.ID
+		f.ID, f.BoardID, f.CreatedAt = uuid.New().String(), toBoardID, now
+		if err := s.fields.Create(ctx, &f); err != nil {
+			return nil, fmt.Errorf("copy custom field: %w", err)
+		}
+		ids[oldID] = f.ID
+	}
+	return ids, nil
+}
+
+// Duplicate copies a column and its cards, placing the copy right after the original.
+// The copy follows the same workflow rules as the original, and each copied card is
+// announced like a created one.
+func (s *ColumnService) Duplicate(ctx context.Context, id string) (*domain.Column, error) {
+	var col *domain.Column
+	var cards []domain.Card
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		src, err := s.columns.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		siblings, err := s.columns.GetByBoardID(ctx, src.BoardID)
+		if err != nil {
+			return err
+		}
+		if cards, err = s.cards.GetByColumnID(ctx, id); err != nil {
+			return err
+		}
+
+		positions := make([]int, len(siblings))
+		idx := 0
+		for i, c := range siblings {
+			positions[i] = c.Position
+			if c.ID == id {
+				idx = i
+			}
+		}
+
+		title := src.Title + copySuffix
+		if utf8.RuneCountInString(title) > domain.MaxColumnTitleLength {
+			title = src.Title
+		}
+		now := time.Now().UTC()
+		col = &domain.Column{ID: uuid.New().String(), BoardID: src.BoardID, Title: title, Kind: src.Kind, CreatedAt: now}
+		col.Position = positionAfter(positions, idx)
+		if col.Position < 0 {
+			// No gap left after the original: respace the board's columns first.
+			for i, c := range siblings {
+				if err := s.columns.UpdatePosition(ctx, c.ID, (i+1)*1000); err != nil {
+					return err
+				}
+			}
+			col.Position = (idx+1)*1000 + 500
+		}
+		if err := s.columns.Create(ctx, col); err != nil {
+			return err
+		}
+		for i, c := range cards {
+			cards[i] = copyCard(c, col.ID, c.Position, now)
+			if err := s.cards.Create(ctx, &cards[i]); err != nil {
+				return fmt.Errorf("copy card: %w", err)
+			}
+		}
+		return s.copyRules(ctx, src, col.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range cards {
+		s.activity.publishCreated(ctx, c.ID, col)
+	}
+	return col, nil
+}
+
+// copyRules gives column copyID the workflow rules of src: if src is restricted, the
+// copy may move to the same columns, and every column that may move into src may
+// also move into the copy.
+func (s *ColumnService) copyRules(ctx context.Context, src *domain.Column, copyID string) error {
+	w, err := s.workflows.GetByBoardID(ctx, src.BoardID)
+	if err != nil {
+		return err
+	}
+	if len(w.Transitions) == 0 {
+		return nil
+	}
+	for from, targets := range w.Transitions {
+		if slices.Contains(targets, src.ID) {
+			w.Transitions[from] = append(targets, copyID)
+		}
+	}
+	if targets, ok := w.Transitions[src.ID]; ok {
+		w.Transitions[copyID] = slices.Clone(targets)
+	}
+	return s.workflows.Replace(ctx, w)
+}
+
+// Duplicate copies a card into targetColumnID (its own column when empty).
+// In the same column the copy is placed right after the original; otherwise, or when
+// the original is archived, at the end.
+func (s *CardService) Duplicate(ctx context.Context, id, targetColumnID string) (*domain.Card, error) {
+	src, err := s.cards.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if targetColumnID == "" {
+		targetColumnID = src.ColumnID
+	}
+	from, err := s.columns.GetByID(ctx, src.ColumnID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.columns.GetByID(ctx, targetColumnID)
+	if err != nil {
+		return nil, err
+	}
+
+	var card domain.Card
+	now := time.Now().UTC()
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		position, err := s.copyPosition(ctx, src, targetColumnID)
+		if err != nil {
+			return err
+		}
+		card = copyCard(*src, targetColumnID, position, now)
+		if card.Priority, err = remapPriority(ctx, s.boards, src.Priority, from.BoardID, to.BoardID); err != nil {
+			return err
+		}
+		if card.CustomFields, err = remapFields(ctx, s.fields, src.CustomFields, from.BoardID, to.BoardID); err != nil {
+			return err
+		}
+		return s.cards.Create(ctx, &card)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.activity.publishCreated(ctx, card.ID, to)
+	return &card, nil
+}
+
+// copyPosition picks where a copy of src goes in targetColumnID, respacing the
+// column when there is no gap right after the original.
+func (s *CardService) copyPosition(ctx context.Context, src *domain.Card, targetColumnID string) (int, error) {
+	if targetColumnID != src.ColumnID {
+		maxPos, err := s.cards.MaxPosition(ctx, targetColumnID)
+		return maxPos + 1000, err
+	}
+
+	siblings, err := s.cards.GetByColumnID(ctx, src.ColumnID)
+	if err != nil {
+		return 0, err
+	}
+	positions := make([]int, len(siblings))
+	idx := -1
+	for i, c := range siblings {
+		positions[i] = c.Position
+		if c.ID == src.ID {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		// An archived original is not among its column's cards; add the copy at the end.
+		maxPos, err := s.cards.MaxPosition(ctx, targetColumnID)
+		return maxPos + 1000, err
+	}
+	if pos := positionAfter(positions, idx); pos >= 0 {
+		return pos, nil
+	}
+	for i, c := range siblings {
+		if err := s.cards.Move(ctx, c.ID, c.ColumnID, (i+1)*1000); err != nil {
+			return 0, err
+		}
+	}
+	return (idx+1)*1000 + 500, nil
+}
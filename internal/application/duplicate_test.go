@@ -0,0 +1,89 @@
+package application
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"kanban-app-playground/internal/domain"
+)
+
+func TestDuplicateColumn(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestServices(t)
+	board, err := svc.boards.Create(ctx, "Duplicate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := svc.boards.GetWithData(ctx, board.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	todo, doing, done := data.Columns[0].Column.ID, data.Columns[1].Column.ID, data.Columns[2].Column.ID
+	if _, err := svc.boards.SetWorkflow(ctx, board.ID, map[string][]string{
+		todo: {doing}, doing: {done},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.cards.Create(ctx, doing, "Card"); err != nil {
+		t.Fatal(err)
+	}
+
+	var created []string
+	svc.feed.SubscribeCards(func(_ context.Context, a domain.CardActivity) {
+		if a.Kind == domain.TriggerCardCreated {
+			created = append(created, a.ColumnID)
+		}
+	})
+	col, err := svc.columns.Duplicate(ctx, doing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{col.ID}; !reflect.DeepEqual(created, want) {
+		t.Errorf("created activity in %v, want %v", created, want)
+	}
+
+	w, err := svc.boards.Workflow(ctx, board.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{doing, col.ID}; !reflect.DeepEqual(w.Transitions[todo], want) {
+		t.Errorf("todo may move to %v, want %v", w.Transitions[todo], want)
+	}
+	if want := []string{done}; !reflect.DeepEqual(w.Transitions[col.ID], want) {
+		t.Errorf("copy may move to %v, want %v", w.Transitions[col.ID], want)
+	}
+}
+
+func TestDuplicateArchivedCard(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestServices(t)
+	board, err := svc.boards.Create(ctx, "Duplicate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := svc.boards.GetWithData(ctx, board.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	column := data.Columns[0].Column.ID
+	var cards []*domain.Card
+	for _, title := range []string{"First", "Second", "Third"} {
+		card, err := svc.cards.Create(ctx, column, title)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cards = append(cards, card)
+	}
+	if _, err := svc.cards.BulkArchive(ctx, []string{cards[1].ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	dup, err := svc.cards.Duplicate(ctx, cards[1].ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dup.Position <= cards[2].Position {
+		t.Errorf("copy position = %d, want after %d", dup.Position, cards[2].Position)
+	}
+}
@@ -0,0 +1,241 @@
+package application
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sort"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// Flow zones a column falls into, by its kind.
+const (
+	zoneBacklog = iota
+	zoneActive
+	zoneDone
+)
+
+// cardFlow is the replayed state of one card while walking board history.
+type cardFlow struct {
+	created   time.Time
+	started   *time.Time
+	completed *time.Time
+	columnID  string
+	onBoard   bool
+	archived  bool
+}
+
+// FlowMetrics computes lead and cycle times, their percentiles, weekly throughput
+// and aging work in progress for boardID.
+//
+// A card starts the first time it reaches an active or done column, and completes the
+// last time it reaches a done column; moving back out of the done columns reopens it.
+// Completed cards that were later archived still count; deleted cards and cards moved
+// to another board do not.
+func (s *AnalyticsService) FlowMetrics(ctx context.Context, boardID string, filter domain.FlowMetricsFilter) (*domain.FlowMetrics, error) {
+	now := s.now()
+	if filter.To == "" {
+		filter.To = now.In(s.loc).Format(time.DateOnly)
+	}
+	if filter.From == "" {
+		if to, err := time.ParseInLocation(time.DateOnly, filter.To, s.loc); err == nil {
+			filter.From = to.AddDate(0, 0, 1-domain.DefaultMetricsDays).Format(time.DateOnly)
+		}
+	}
+
+	board, err := s.boards.GetByID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	defs, err := s.fields.GetByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	v := domain.NewValidator()
+	rng := s.parseRange(v, filter.From, filter.To, domain.IntervalWeek)
+	for _, p := range filter.Priorities {
+		v.Priority("priorities", p, board.Priorities)
+	}
+	filterFields := resolveFieldFilters(v, "fields", defs, filter.Fields)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	start, done, flows, err := s.boardFlows(ctx, boardID, now)
+	if err != nil {
+		return nil, err
+	}
+	cards, err := s.cards.GetByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := &domain.FlowMetrics{
+		BoardID:       boardID,
+		From:          filter.From,
+		To:            filter.To,
+		StartColumnID: start.ID,
+		DoneColumnID:  done.ID,
+		Cards:         []domain.CardFlowTime{},
+		Throughput:    make([]domain.ThroughputPoint, len(rng.starts)),
+		AgingWIP:      []domain.AgingCard{},
+	}
+	for i, t := range rng.starts {
+		metrics.Throughput[i].WeekStart = t.Format(time.DateOnly)
+	}
+
+	var leads, cycles []float64
+	for _, card := range cards {
+		if len(filter.Priorities) > 0 && !slices.Contains(filter.Priorities, card.Priority) {
+			continue
+		}
+		if !matchesFields(card, filterFields, filter.Fields) {
+			continue
+		}
+		f, ok := flows[card.ID]
+		if !ok || !f.onBoard && !f.archived {
+			continue
+		}
+
+		if f.completed == nil {
+			if f.started != nil && f.onBoard {
+				metrics.AgingWIP = append(metrics.AgingWIP, domain.AgingCard{
+					CardID:    card.ID,
+					Title:     card.Title,
+					Priority:  card.Priority,
+					ColumnID:  f.columnID,
+					StartedAt: *f.started,
+					AgeDays:   days(now.Sub(*f.started)),
+				})
+			}
+			continue
+		}
+
+		completed := *f.completed
+		if completed.Before(rng.from) || !completed.Before(rng.end) {
+			continue
+		}
+		ft := domain.CardFlowTime{
+			CardID:        card.ID,
+			Title:         card.Title,
+			Priority:      card.Priority,
+			CreatedAt:     f.created,
+			StartedAt:     *f.started,
+			CompletedAt:   completed,
+			LeadTimeDays:  days(completed.Sub(f.created)),
+			CycleTimeDays: days(completed.Sub(*f.started)),
+			Estimate:      card.Estimate,
+		}
+		metrics.Cards = append(metrics.Cards, ft)
+		leads = append(leads, ft.LeadTimeDays)
+		cycles = append(cycles, ft.CycleTimeDays)
+		for i := range rng.starts {
+			if !completed.Before(rng.starts[i]) && completed.Before(rng.bucketEnd(i)) {
+				metrics.Throughput[i].Count++
+				if card.Estimate != nil {
+					metrics.Throughput[i].Points += *card.Estimate
+				}
+				break
+			}
+		}
+	}
+
+	sort.Slice(metrics.Cards, func(i, j int) bool {
+		return metrics.Cards[i].CompletedAt.Before(metrics.Cards[j].CompletedAt)
+	})
+	sort.Slice(metrics.AgingWIP, func(i, j int) bool {
+		return metrics.AgingWIP[i].StartedAt.Before(metrics.AgingWIP[j].StartedAt)
+	})
+	metrics.LeadTime = percentiles(leads)
+	metrics.CycleTime = percentiles(cycles)
+	return metrics, nil
+}
+
+// boardFlows resolves boardID's start and done columns and replays its history up to
+// until. Zones come from column kinds, the same source stats and completed_at use.
+func (s *AnalyticsService) boardFlows(ctx context.Context, boardID string, until time.Time) (start, done *domain.Column, flows map[string]*cardFlow, err error) {
+	board, err := s.boards.GetByID(ctx, boardID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	cols, err := s.columns.GetByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	events, err := s.history.ListByBoard(ctx, boardID, until)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	start, done = board.FlowColumns(cols)
+	zones := make(map[string]int, len(cols))
+	for _, c := range cols {
+		switch c.Kind {
+		case domain.ColumnKindDone:
+			zones[c.ID] = zoneDone
+		case domain.ColumnKindActive:
+			zones[c.ID] = zoneActive
+		}
+	}
+	return start, done, replayFlow(events, boardID, zones), nil
+}
+
+// replayFlow walks boardID's history in order and returns each card's final flow state.
+// zones maps current column IDs to their zone; unknown (deleted) columns count as backlog.
+func replayFlow(events []domain.CardEvent, boardID string, zones map[string]int) map[string]*cardFlow {
+	flows := make(map[string]*cardFlow)
+	for _, e := range events {
+		f, ok := flows[e.CardID]
+		if !ok {
+			f = &cardFlow{created: e.At}
+			flows[e.CardID] = f
+		}
+
+		if e.ToColumnID == "" || e.BoardID != boardID {
+			f.onBoard = false
+			f.archived = e.Kind == domain.CardEventArchived
+			continue
+		}
+
+		f.columnID, f.onBoard, f.archived = e.ToColumnID, true, false
+		at := e.At
+		switch zones[e.ToColumnID] {
+		case zoneDone:
+			if f.started == nil {
+				f.started = &at
+			}
+			if f.completed == nil {
+				f.completed = &at
+			}
+		case zoneActive:
+			if f.started == nil {
+				f.started = &at
+			}
+			f.completed = nil
+		default:
+			f.completed = nil
+		}
+	}
+	return flows
+}
+
+// percentiles returns the nearest-rank 50th, 85th and 95th percentiles of values.
+func percentiles(values []float64) domain.Percentiles {
+	if len(values) == 0 {
+		return domain.Percentiles{}
+	}
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	rank := func(p float64) float64 {
+		i := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+		return sorted[max(i, 0)]
+	}
+	return domain.Percentiles{P50: rank(50), P85: rank(85), P95: rank(95)}
+}
+
+// days converts d to days, rounded to two decimals.
+func days(d time.Duration) float64 {
+	return math.Round(d.Hours()/24*100) / 100
+}
@@ -0,0 +1,236 @@
+package application
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// pointScale converts estimate points to the whole units simulations count in,
+// so fractional estimates (half an hour, say) survive integer arithmetic.
+const pointScale = 100
+
+// dailyThroughput returns how much boardID completed on each of the last
+// DefaultMetricsDays full days, starting no earlier than the board's first history event.
+// In points mode each card weighs its estimate times pointScale; unestimated cards weigh nothing.
+func (s *AnalyticsService) dailyThroughput(ctx context.Context, boardID, unit string, today time.Time) ([]int, error) {
+	_, _, flows, err := s.boardFlows(ctx, boardID, today)
+	if err != nil {
+		return nil, err
+	}
+	weights := map[string]int{}
+	if unit == domain.ForecastUnitPoints {
+		cards, err := s.cards.GetByBoardID(ctx, boardID)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range cards {
+			if c.Estimate != nil {
+				weights[c.ID] = int(math.Round(*c.Estimate * pointScale))
+			}
+		}
+	}
+
+	first := today
+	for _, f := range flows {
+		if f.created.Before(first) {
+			first = f.created
+		}
+	}
+	n := domain.DefaultMetricsDays
+	if since := int(today.Sub(first).Hours()/24) + 1; since < n {
+		n = since
+	}
+	windowStart := today.AddDate(0, 0, -n)
+
+	samples := make([]int, n)
+	total := 0
+	for id, f := range flows {
+		if f.completed == nil || !f.onBoard && !f.archived {
+			continue
+		}
+		weight := 1
+		if unit == domain.ForecastUnitPoints {
+			weight = weights[id]
+		}
+		c := f.completed.In(s.loc)
+		if c.Before(windowStart) || !c.Before(today) {
+			continue
+		}
+		day := time.Date(c.Year(), c.Month(), c.Day(), 0, 0, 0, 0, s.loc)
+		samples[int(day.Sub(windowStart).Hours()/24+0.5)] += weight
+		total += weight
+	}
+	if total == 0 {
+		return nil, domain.ErrInsufficientHistory
+	}
+	return samples, nil
+}
+
+// forecastSetup validates simulation options and returns the effective values,
+// the start of today and a seeded random source. A zero seed picks one at random.
+// An empty unit means cards; the returned scale converts amounts into simulation units.
+func (s *AnalyticsService) forecastSetup(v *domain.Validator, unit *string, simulations int, seed int64) (int, float64, int64, time.Time, *rand.Rand) {
+	scale := 1.0
+	switch *unit {
+	case "":
+		*unit = domain.ForecastUnitCards
+	case domain.ForecastUnitCards:
+	case domain.ForecastUnitPoints:
+		scale = pointScale
+	default:
+		v.Add("unit", domain.CodeInvalidValue, "unit must be cards or points")
+	}
+	if simulations == 0 {
+		simulations = domain.DefaultSimulations
+	}
+	if simulations < 0 || simulations > domain.MaxSimulations {
+		v.Add("simulations", domain.CodeInvalidValue, "simulations must be between 1 and 20000")
+	}
+	if seed == 0 {
+		seed = rand.Int64()
+	}
+	now := s.now().In(s.loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, s.loc)
+	return simulations, scale, seed, today, rand.New(rand.NewPCG(uint64(seed), 0))
+}
+
+// ForecastCompletion simulates when the remaining work — a number of cards, or of
+// estimate points when unit is "points" — will be finished. Each simulated day
+// (today counting as day one) draws its throughput from a random past day.
+func (s *AnalyticsService) ForecastCompletion(ctx context.Context, boardID, unit string, remaining float64, simulations int, seed int64) (*domain.CompletionForecast, error) {
+	v := domain.NewValidator()
+	simulations, scale, seed, today, rng := s.forecastSetup(v, &unit, simulations, seed)
+	if !(remaining > 0) || remaining*scale > math.MaxInt32 {
+		v.Add("remaining", domain.CodeInvalidValue, "remaining must be a positive amount")
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+	samples, err := s.dailyThroughput(ctx, boardID, unit, today)
+	if err != nil {
+		return nil, err
+	}
+	target := int(math.Ceil(remaining*scale - 1e-9))
+
+	finished := make([]int, domain.MaxForecastDays+1) // trials finishing on day d (1-based)
+	incomplete := 0
+	for range simulations {
+		done, day := 0, 0
+		for done < target && day < domain.MaxForecastDays {
+			day++
+			done += samples[rng.IntN(len(samples))]
+		}
+		if done < target {
+			incomplete++
+			continue
+		}
+		finished[day]++
+	}
+
+	forecast := &domain.CompletionForecast{
+		BoardID:      boardID,
+		Unit:         unit,
+		Remaining:    remaining,
+		Simulations:  simulations,
+		Seed:         seed,
+		HistoryDays:  len(samples),
+		Distribution: []domain.DateProbability{},
+		Incomplete:   incomplete,
+	}
+	cumulative := 0
+	next := 0
+	for day, n := range finished {
+		if n == 0 {
+			continue
+		}
+		cumulative += n
+		date := today.AddDate(0, 0, day-1).Format(time.DateOnly)
+		share := float64(cumulative) / float64(simulations)
+		forecast.Distribution = append(forecast.Distribution, domain.DateProbability{
+			Date:        date,
+			Probability: float64(n) / float64(simulations),
+			Cumulative:  share,
+		})
+		for ; next < len(domain.ForecastConfidences) && share*100 >= float64(domain.ForecastConfidences[next]); next++ {
+			forecast.Confidence = append(forecast.Confidence, domain.DateConfidence{
+				Confidence: domain.ForecastConfidences[next], Date: date,
+			})
+		}
+	}
+	for ; next < len(domain.ForecastConfidences); next++ {
+		forecast.Confidence = append(forecast.Confidence, domain.DateConfidence{Confidence: domain.ForecastConfidences[next]})
+	}
+	return forecast, nil
+}
+
+// ForecastCardsByDate simulates how many cards — or estimate points when unit is
+// "points" — will be completed from today through date (YYYY-MM-DD, inclusive).
+func (s *AnalyticsService) ForecastCardsByDate(ctx context.Context, boardID, unit, date string, simulations int, seed int64) (*domain.CardsForecast, error) {
+	v := domain.NewValidator()
+	simulations, scale, seed, today, rng := s.forecastSetup(v, &unit, simulations, seed)
+	target, err := time.ParseInLocation(time.DateOnly, date, s.loc)
+	if err != nil {
+		v.Add("date", domain.CodeInvalidFormat, "date must be YYYY-MM-DD")
+	}
+	days := int(target.Sub(today).Hours()/24+0.5) + 1
+	if err == nil && (days < 1 || days > domain.MaxForecastDays) {
+		v.Add("date", domain.CodeInvalidValue, "date must be between today and five years from now")
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+	samples, err := s.dailyThroughput(ctx, boardID, unit, today)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[int]int)
+	for range simulations {
+		done := 0
+		for range days {
+			done += samples[rng.IntN(len(samples))]
+		}
+		counts[done]++
+	}
+
+	forecast := &domain.CardsForecast{
+		BoardID:      boardID,
+		Unit:         unit,
+		Date:         date,
+		Simulations:  simulations,
+		Seed:         seed,
+		HistoryDays:  len(samples),
+		Distribution: []domain.CountProbability{},
+	}
+	// Walk from the highest amount down so AtLeast accumulates naturally.
+	amounts := make([]int, 0, len(counts))
+	for amount := range counts {
+		amounts = append(amounts, amount)
+	}
+	slices.Sort(amounts)
+	slices.Reverse(amounts)
+	atLeast := 0
+	next := 0
+	for _, amount := range amounts {
+		n := counts[amount]
+		atLeast += n
+		share := float64(atLeast) / float64(simulations)
+		forecast.Distribution = append(forecast.Distribution, domain.CountProbability{
+			Amount:      float64(amount) / scale,
+			Probability: float64(n) / float64(simulations),
+			AtLeast:     share,
+		})
+		for ; next < len(domain.ForecastConfidences) && share*100 >= float64(domain.ForecastConfidences[next]); next++ {
+			forecast.Confidence = append(forecast.Confidence, domain.CountConfidence{
+				Confidence: domain.ForecastConfidences[next], Amount: float64(amount) / scale,
+			})
+		}
+	}
+	slices.Reverse(forecast.Distribution)
+	return forecast, nil
+}
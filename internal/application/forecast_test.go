@@ -0,0 +1,110 @@
+package application
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+func TestForecastSeedIsDeterministic(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestServices(t)
+	board, err := svc.boards.Create(ctx, "Forecast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := svc.boards.GetWithData(ctx, board.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	todo, done := data.Columns[0].Column.ID, data.Columns[len(data.Columns)-1].Column.ID
+	for i, estimate := range []string{"1", "2", "3", "5", ""} {
+		card, err := svc.cards.Create(ctx, todo, "card")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if estimate != "" {
+			if _, err := svc.cards.Update(ctx, card.ID, domain.CardUpdate{Estimate: &estimate}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if i < 4 {
+			if err := svc.cards.Move(ctx, card.ID, done, 0, false); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	// Completions only count once their day is over.
+	svc.analytics.now = func() time.Time { return time.Now().Add(72 * time.Hour) }
+	date := time.Now().Add(72*time.Hour).AddDate(0, 0, 14).Format(time.DateOnly)
+
+	tests := []struct {
+		name string
+		run  func(seed int64) (any, int64, error)
+	}{
+		{"completion in cards", func(seed int64) (any, int64, error) {
+			f, err := svc.analytics.ForecastCompletion(ctx, board.ID, "", 10, 500, seed)
+			if err != nil {
+				return nil, 0, err
+			}
+			return f, f.Seed, nil
+		}},
+		{"completion in points", func(seed int64) (any, int64, error) {
+			f, err := svc.analytics.ForecastCompletion(ctx, board.ID, domain.ForecastUnitPoints, 20.5, 500, seed)
+			if err != nil {
+				return nil, 0, err
+			}
+			return f, f.Seed, nil
+		}},
+		{"cards by date", func(seed int64) (any, int64, error) {
+			f, err := svc.analytics.ForecastCardsByDate(ctx, board.ID, domain.ForecastUnitCards, date, 500, seed)
+			if err != nil {
+				return nil, 0, err
+			}
+			return f, f.Seed, nil
+		}},
+		{"points by date", func(seed int64) (any, int64, error) {
+			f, err := svc.analytics.ForecastCardsByDate(ctx, board.ID, domain.ForecastUnitPoints, date, 500, seed)
+			if err != nil {
+				return nil, 0, err
+			}
+			return f, f.Seed, nil
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, seed, err := tt.run(42)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if seed != 42 {
+				t.Errorf("seed = %d, want 42", seed)
+			}
+			second, _, err := tt.run(42)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(first, second) {
+				t.Errorf("same seed gave different forecasts:\n%+v\n%+v", first, second)
+			}
+
+			random, seed, err := tt.run(0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if seed == 0 {
+				t.Fatal("zero seed was not replaced")
+			}
+			replay, _, err := tt.run(seed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(random, replay) {
+				t.Errorf("replaying seed %d gave a different forecast", seed)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package application
+
+import (
+	"path/filepath"
+	"testing"
+
+	"kanban-app-playground/internal/infrastructure/sqlite"
+)
+
+// testServices holds services wired to a fresh database.
+type testServices struct {
+	boards    *BoardService
+	columns   *ColumnService
+	cards     *CardService
+	analytics *AnalyticsService
+	people    *PersonService
+	fields    *CustomFieldService
+	times     *TimeService
+	feed      *ActivityFeed
+}
+
+// newTestServices opens a database in a temporary directory and wires the services
+// tests use to it.
+func newTestServices(t *testing.T) *testServices {
+	t.Helper()
+	db, err := sqlite.OpenDB(filepath.Join(t.TempDir(), "data.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	boards, columns, cards := sqlite.NewBoardRepo(db), sqlite.NewColumnRepo(db), sqlite.NewCardRepo(db)
+	history, fields, workflows := sqlite.NewHistoryRepo(db), sqlite.NewCustomFieldRepo(db), sqlite.NewWorkflowRepo(db)
+	tx, feed := sqlite.NewTransactor(db), NewActivityFeed()
+	return &testServices{
+		boards:  NewBoardService(boards, columns, cards, sqlite.NewBoardTemplateRepo(db), fields, workflows, feed, tx),
+		columns: NewColumnService(columns, cards, boards, history, workflows, feed, tx),
+		cards: NewCardService(cards, columns, boards, sqlite.NewCardTemplateRepo(db), history, sqlite.NewPersonRepo(db),
+			fields, workflows, sqlite.NewUndoRepo(db), feed, tx),
+		analytics: NewAnalyticsService(boards, columns, cards, history, sqlite.NewStatsRepo(db), fields),
+		people:    NewPersonService(sqlite.NewPersonRepo(db)),
+		fields:    NewCustomFieldService(fields, boards, columns, cards, tx),
+		times:     NewTimeService(sqlite.NewTimeEntryRepo(db), cards, tx),
+		feed:      feed,
+	}
+}
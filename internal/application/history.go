@@ -0,0 +1,37 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// recordMove appends a move event for cardID going from one column to another.
+// Reorders within a column are not history and are skipped.
+func recordMove(ctx context.Context, history domain.HistoryRepository, cardID string, from, to *domain.Column, at time.Time) error {
+	if from.ID == to.ID {
+		return nil
+	}
+	event := &domain.CardEvent{
+		CardID:       cardID,
+		BoardID:      to.BoardID,
+		Kind:         domain.CardEventMoved,
+		FromColumnID: from.ID,
+		ToColumnID:   to.ID,
+		At:           at,
+	}
+	if from.BoardID != to.BoardID {
+		event.FromBoardID = from.BoardID
+	}
+	if err := history.Record(ctx, event); err != nil {
+		return fmt.Errorf("record move: %w", err)
+	}
+	return nil
+}
+
+// History returns a card's recorded events, oldest first.
+func (s *CardService) History(ctx context.Context, cardID string) ([]domain.CardEvent, error) {
+	return s.history.ListByCard(ctx, cardID)
+}
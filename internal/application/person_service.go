@@ -0,0 +1,128 @@
+package application
+
+import (
+	"context"
+	"hash/fnv"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// colorPattern matches #RRGGBB avatar colors.
+var colorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// personPalette is the set of avatar colors assigned when none is given.
+var personPalette = []string{
+	"#EF4444", "#F97316", "#EAB308", "#22C55E", "#14B8A6",
+	"#3B82F6", "#6366F1", "#A855F7", "#EC4899", "#64748B",
+}
+
+type PersonService struct {
+	people domain.PersonRepository
+}
+
+func NewPersonService(people domain.PersonRepository) *PersonService {
+	return &PersonService{people: people}
+}
+
+// normalizePerson validates in and fills derived initials and color.
+func normalizePerson(in domain.PersonInput) (domain.PersonInput, error) {
+	in.Name = strings.TrimSpace(in.Name)
+	in.Initials = strings.TrimSpace(in.Initials)
+	in.Email = strings.TrimSpace(in.Email)
+
+	v := domain.NewValidator()
+	v.Title("name", in.Name, domain.MaxPersonNameLength)
+	v.MaxLength("initials", in.Initials, domain.MaxInitialsLength)
+	if in.Color != "" && !colorPattern.MatchString(in.Color) {
+		v.Add("color", domain.CodeInvalidFormat, "color must be #RRGGBB")
+	}
+	v.MaxLength("email", in.Email, domain.MaxEmailLength)
+	if in.Email != "" {
+		if addr, err := mail.ParseAddress(in.Email); err != nil || addr.Address != in.Email {
+			v.Add("email", domain.CodeInvalidFormat, "email must be a plain address like name@example.com")
+		}
+	}
+	if err := v.Err(); err != nil {
+		return in, err
+	}
+
+	if in.Initials == "" {
+		in.Initials = initialsOf(in.Name)
+	}
+	if in.Color == "" {
+		h := fnv.New32a()
+		h.Write([]byte(in.Name))
+		in.Color = personPalette[h.Sum32()%uint32(len(personPalette))]
+	}
+	return in, nil
+}
+
+// initialsOf returns the upper-cased first letters of the first and last word of
+// name, or the first letter of a single-word name.
+func initialsOf(name string) string {
+	words := strings.Fields(name)
+	if len(words) == 0 {
+		return ""
+	}
+	first, _ := utf8.DecodeRuneInString(words[0])
+	initials := string(unicode.ToUpper(first))
+	if len(words) > 1 {
+		last, _ := utf8.DecodeRuneInString(words[len(words)-1])
+		initials += string(unicode.ToUpper(last))
+	}
+	return initials
+}
+
+func (s *PersonService) List(ctx context.Context) ([]domain.Person, error) {
+	return s.people.GetAll(ctx)
+}
+
+func (s *PersonService) Create(ctx context.Context, in domain.PersonInput) (*domain.Person, error) {
+	in, err := normalizePerson(in)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &domain.Person{
+		ID:        uuid.New().String(),
+		Name:      in.Name,
+		Initials:  in.Initials,
+		Color:     in.Color,
+		Email:     in.Email,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.people.Create(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (s *PersonService) Update(ctx context.Context, id string, in domain.PersonInput) (*domain.Person, error) {
+	in, err := normalizePerson(in)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.people.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	p.Name, p.Initials, p.Color, p.Email = in.Name, in.Initials, in.Color, in.Email
+	if err := s.people.Update(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Delete removes a person and unassigns them from every card.
+func (s *PersonService) Delete(ctx context.Context, id string) error {
+	return s.people.Delete(ctx, id)
+}
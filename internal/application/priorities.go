@@ -0,0 +1,100 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// priorityKeyPattern matches priority keys: lowercase slugs such as "p0" or "wont_fix".
+var priorityKeyPattern = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// validatePriorities checks a priority scheme and returns it trimmed and ordered by weight.
+func validatePriorities(levels []domain.PriorityLevel) ([]domain.PriorityLevel, error) {
+	out := make([]domain.PriorityLevel, len(levels))
+	for i, l := range levels {
+		l.Key = strings.TrimSpace(l.Key)
+		l.Name = strings.TrimSpace(l.Name)
+		out[i] = l
+	}
+
+	v := domain.NewValidator()
+	if len(out) == 0 {
+		v.Add("priorities", domain.CodeRequired, "a board needs at least one priority level")
+	}
+	if len(out) > domain.MaxPriorityLevels {
+		v.Add("priorities", domain.CodeInvalidValue, fmt.Sprintf("a board can have at most %d priority levels", domain.MaxPriorityLevels))
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+	// Report only the first bad level.
+	for i, l := range out {
+		v := domain.NewValidator()
+		v.Title("name", l.Name, domain.MaxPriorityNameLength)
+		switch {
+		case len(l.Key) > domain.MaxPriorityKeyLength:
+			v.Add("key", domain.CodeTooLong, fmt.Sprintf("key must be at most %d characters", domain.MaxPriorityKeyLength))
+		case !priorityKeyPattern.MatchString(l.Key):
+			v.Add("key", domain.CodeInvalidFormat, "key must use lowercase letters, digits, - and _")
+		case slices.ContainsFunc(out[:i], func(o domain.PriorityLevel) bool { return o.Key == l.Key }):
+			v.Add("key", domain.CodeInvalidValue, "key "+l.Key+" is listed twice")
+		}
+		if !colorPattern.MatchString(l.Color) {
+			v.Add("color", domain.CodeInvalidFormat, "color must be #RRGGBB")
+		}
+		if slices.ContainsFunc(out[:i], func(o domain.PriorityLevel) bool { return o.Weight == l.Weight }) {
+			v.Add("weight", domain.CodeInvalidValue, fmt.Sprintf("weight %d is used by two levels", l.Weight))
+		}
+		if err := v.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	slices.SortFunc(out, func(a, b domain.PriorityLevel) int { return a.Weight - b.Weight })
+	return out, nil
+}
+
+// SetPriorities replaces a board's priority scheme. Cards, card templates and
+// recurrences on a removed level move to the remaining level nearest in weight.
+func (s *BoardService) SetPriorities(ctx context.Context, id string, levels []domain.PriorityLevel) (*domain.Board, error) {
+	levels, err := validatePriorities(levels)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := s.boards.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	remap := make(map[string]string)
+	for _, old := range board.Priorities {
+		if _, ok := domain.FindPriority(levels, old.Key); !ok {
+			remap[old.Key] = domain.NearestPriority(levels, old.Weight)
+		}
+	}
+	if err := s.boards.SetPriorities(ctx, id, levels, remap); err != nil {
+		return nil, err
+	}
+	return s.boards.GetByID(ctx, id)
+}
+
+// remapPriority converts a priority key from fromBoardID's scheme to toBoardID's.
+func remapPriority(ctx context.Context, boards domain.BoardRepository, key, fromBoardID, toBoardID string) (string, error) {
+	if fromBoardID == toBoardID {
+		return key, nil
+	}
+	from, err := boards.GetByID(ctx, fromBoardID)
+	if err != nil {
+		return "", err
+	}
+	to, err := boards.GetByID(ctx, toBoardID)
+	if err != nil {
+		return "", err
+	}
+	return domain.MapPriority(key, from.Priorities, to.Priorities), nil
+}
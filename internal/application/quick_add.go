@@ -0,0 +1,200 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// QuickAdd creates a card in columnID from one line of text with inline tokens,
+// e.g. "Fix login !high #backend @alice due:friday ~3". Each token is resolved
+// against the board: priorities by key or name, labels as options of the board's
+// multi-select fields, assignees by name or initials, due dates by
+// domain.ParseRelativeDate and estimates on the board's scale. Tokens that do not
+// resolve stay in the title and are reported in the diagnostics.
+func (s *CardService) QuickAdd(ctx context.Context, columnID, text string) (*domain.QuickAddResult, error) {
+	col, err := s.columns.GetByID(ctx, columnID)
+	if err != nil {
+		return nil, fmt.Errorf("column not found: %w", err)
+	}
+	board, err := s.boards.GetByID(ctx, col.BoardID)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := s.fields.GetByBoardID(ctx, board.ID)
+	if err != nil {
+		return nil, err
+	}
+	people, err := s.people.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		updates   domain.CardUpdate
+		hasUpdate bool
+		assignees []string
+		// labels holds the options picked per multi-select field ID.
+		labels  = map[string][]any{}
+		applied []domain.QuickAddToken
+		diags   = []domain.QuickAddDiagnostic{}
+	)
+	for _, tok := range domain.ParseQuickAdd(text) {
+		d := domain.QuickAddDiagnostic{Token: tok.Raw, Kind: tok.Kind}
+		switch tok.Kind {
+		case domain.QuickAddPriority:
+			if level, ok := quickAddPriority(board.Priorities, tok.Value); ok {
+				updates.Priority = &level.Key
+				hasUpdate = true
+				d.Value = level.Key
+			} else {
+				d.Message = fmt.Sprintf("no priority named %q on this board", tok.Value)
+			}
+
+		case domain.QuickAddLabel:
+			if fieldID, option, ok := quickAddLabel(fields, tok.Value); ok {
+				if !slices.Contains(labels[fieldID], any(option)) {
+					labels[fieldID] = append(labels[fieldID], option)
+				}
+				d.Value = option
+			} else {
+				d.Message = fmt.Sprintf("no multi-select field has the option %q", tok.Value)
+			}
+
+		case domain.QuickAddAssignee:
+			switch matches := quickAddPeople(people, tok.Value); len(matches) {
+			case 0:
+				d.Message = fmt.Sprintf("no person matches %q", tok.Value)
+			case 1:
+				if !slices.Contains(assignees, matches[0].ID) {
+					assignees = append(assignees, matches[0].ID)
+				}
+				d.Value = matches[0].Name
+			default:
+				d.Message = fmt.Sprintf("%q matches %d people", tok.Value, len(matches))
+			}
+
+		case domain.QuickAddDue:
+			if t, ok := domain.ParseRelativeDate(tok.Value, time.Now()); ok {
+				due := t.Format(time.DateOnly)
+				updates.DueAt = &due
+				hasUpdate = true
+				d.Value = due
+			} else {
+				d.Message = fmt.Sprintf("%q is not a date", tok.Value)
+			}
+
+		case domain.QuickAddEstimate:
+			scale := boardEstimateScale(board)
+			if n, ok := scale.Parse(tok.Value); ok {
+				estimate := domain.FormatEstimate(n)
+				updates.Estimate = &estimate
+				hasUpdate = true
+				d.Value = estimate
+			} else {
+				d.Message = fmt.Sprintf("%q is not on the board's %s scale", tok.Value, scale.Kind)
+			}
+		}
+		d.Applied = d.Message == ""
+		if d.Applied {
+			applied = append(applied, tok)
+		}
+		diags = append(diags, d)
+	}
+	title := domain.QuickAddTitle(text, applied)
+
+	var card *domain.Card
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		card = &domain.Card{ColumnID: columnID, Title: title}
+		if _, err := s.insert(ctx, card); err != nil {
+			return err
+		}
+		if hasUpdate {
+			if _, err := s.Update(ctx, card.ID, updates); err != nil {
+				return err
+			}
+		}
+		if len(assignees) > 0 {
+			if err := s.cards.SetAssignees(ctx, card.ID, assignees); err != nil {
+				return err
+			}
+		}
+		return s.setLabels(ctx, card.ID, fields, labels)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.activity.publishCreated(ctx, card.ID, col)
+
+	card, err = s.cards.GetByID(ctx, card.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.QuickAddResult{Card: card, Diagnostics: diags}, nil
+}
+
+// setLabels sets the options picked per multi-select field ID in labels on a card,
+// replacing the field's current value.
+func (s *CardService) setLabels(ctx context.Context, cardID string, fields []domain.CustomField, labels map[string][]any) error {
+	for _, f := range fields {
+		options, ok := labels[f.ID]
+		if !ok {
+			continue
+		}
+		value, _, _ := f.NormalizeValue(options)
+		if err := s.fields.SetValue(ctx, cardID, f.ID, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// quickAddPriority finds the level whose key or name is value, ignoring case.
+func quickAddPriority(levels []domain.PriorityLevel, value string) (domain.PriorityLevel, bool) {
+	for _, l := range levels {
+		if strings.EqualFold(l.Key, value) || strings.EqualFold(l.Name, value) {
+			return l, true
+		}
+	}
+	return domain.PriorityLevel{}, false
+}
+
+// quickAddLabel finds the first multi-select field, in board order, with an option
+// equal to value ignoring case, and returns the option as defined.
+func quickAddLabel(fields []domain.CustomField, value string) (fieldID, option string, ok bool) {
+	for _, f := range fields {
+		if f.Type != domain.FieldMultiSelect {
+			continue
+		}
+		for _, o := range f.Options {
+			if strings.EqualFold(o, value) {
+				return f.ID, o, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// quickAddPeople returns the people value refers to, ignoring case: by full name
+// without spaces, by initials, or by the first word of the name. An exact name
+// match wins over the looser forms.
+func quickAddPeople(people []domain.Person, value string) []domain.Person {
+	var exact, loose []domain.Person
+	for _, p := range people {
+		name := strings.Fields(p.Name)
+		switch {
+		case strings.EqualFold(strings.Join(name, ""), value):
+			exact = append(exact, p)
+		case strings.EqualFold(p.Initials, value), len(name) > 0 && strings.EqualFold(name[0], value):
+			loose = append(loose, p)
+		}
+	}
+	if len(exact) > 0 {
+		return exact
+	}
+	return loose
+}
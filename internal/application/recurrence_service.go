@@ -0,0 +1,358 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// recurrenceScanInterval is how often the scheduler checks for due recurrences.
+const recurrenceScanInterval = time.Minute
+
+type RecurrenceService struct {
+	recurrences domain.RecurrenceRepository
+	cards       domain.CardRepository
+	columns     domain.ColumnRepository
+	templates   domain.CardTemplateRepository
+	cardSvc     *CardService
+	activity    *ActivityFeed
+	tx          domain.Transactor
+	now         func() time.Time
+}
+
+func NewRecurrenceService(
+	recurrences domain.RecurrenceRepository,
+	cards domain.CardRepository,
+	columns domain.ColumnRepository,
+	templates domain.CardTemplateRepository,
+	cardSvc *CardService,
+	activity *ActivityFeed,
+	tx domain.Transactor,
+) *RecurrenceService {
+	return &RecurrenceService{
+		recurrences: recurrences, cards: cards, columns: columns, templates: templates,
+		cardSvc: cardSvc, activity: activity, tx: tx,
+		now: time.Now,
+	}
+}
+
+func (s *RecurrenceService) GetByBoard(ctx context.Context, boardID string) ([]domain.Recurrence, error) {
+	return s.recurrences.GetByBoardID(ctx, boardID)
+}
+
+func (s *RecurrenceService) Delete(ctx context.Context, id string) error {
+	return s.recurrences.Delete(ctx, id)
+}
+
+// Create sets up a recurrence from an existing card (which becomes the first
+// instance) or from a card template.
+func (s *RecurrenceService) Create(ctx context.Context, in domain.RecurrenceInput) (*domain.Recurrence, error) {
+	v := domain.NewValidator()
+	rule, err := domain.ParseRRule(in.Rule)
+	if err != nil {
+		v.Add("rule", domain.CodeInvalidFormat, err.Error())
+	}
+	if in.Mode == "" {
+		in.Mode = domain.RecurrenceOnSchedule
+	}
+	if in.Mode != domain.RecurrenceOnSchedule && in.Mode != domain.RecurrenceOnCompletion {
+		v.Add("mode", domain.CodeInvalidValue, "mode must be schedule or on_completion")
+	}
+	if in.CatchUp == "" {
+		in.CatchUp = domain.CatchUpLatest
+	}
+	if in.CatchUp != domain.CatchUpLatest && in.CatchUp != domain.CatchUpAll {
+		v.Add("catch_up", domain.CodeInvalidValue, "catch_up must be latest or all")
+	}
+	if (in.CardID == "") == (in.TemplateID == "") {
+		v.Add("card_id", domain.CodeRequired, "exactly one of card_id and template_id is required")
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	now := s.now().UTC()
+	rec := &domain.Recurrence{
+		ID:        uuid.New().String(),
+		ColumnID:  in.ColumnID,
+		Rule:      in.Rule,
+		Mode:      in.Mode,
+		CatchUp:   in.CatchUp,
+		StartAt:   now,
+		CreatedAt: now,
+	}
+
+	var boardID string
+	if in.CardID != "" {
+		card, err := s.cards.GetByID(ctx, in.CardID)
+		if err != nil {
+			return nil, err
+		}
+		rec.Title, rec.Description, rec.Priority = card.Title, card.Description, card.Priority
+		rec.LastCardID, rec.Occurrences = card.ID, 1
+		if card.DueAt != nil {
+			rec.StartAt, rec.AllDay = *card.DueAt, card.AllDay
+		}
+		if rec.ColumnID == "" {
+			rec.ColumnID = card.ColumnID
+		}
+		col, err := s.columns.GetByID(ctx, card.ColumnID)
+		if err != nil {
+			return nil, err
+		}
+		boardID = col.BoardID
+	} else {
+		tpl, err := s.templates.GetByID(ctx, in.TemplateID)
+		if err != nil {
+			return nil, err
+		}
+		rec.TemplateID, rec.Priority = tpl.ID, tpl.Priority
+		if rec.ColumnID == "" {
+			v.Add("column_id", domain.CodeRequired, "column_id is required for template recurrences")
+			return nil, v.Err()
+		}
+		boardID = tpl.BoardID
+	}
+
+	if in.StartAt != "" {
+		t, allDay, err := domain.ParseCardDate(in.StartAt)
+		if err != nil {
+			v.Add("start_at", domain.CodeInvalidFormat, "start_at must be YYYY-MM-DD or an RFC3339 date-time")
+			return nil, v.Err()
+		}
+		rec.StartAt, rec.AllDay = t, allDay
+	}
+
+	target, err := s.columns.GetByID(ctx, rec.ColumnID)
+	if err != nil {
+		return nil, err
+	}
+	v.ColumnInBoard("column_id", target, boardID)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+	rec.BoardID = boardID
+
+	// The source card already covers the occurrence at StartAt; template
+	// recurrences start with the first occurrence that hasn't passed yet.
+	after := now
+	if in.CardID != "" && dtstart(rec).After(now) {
+		after = dtstart(rec)
+	}
+	if next, ok := rule.Next(dtstart(rec), after); ok {
+		rec.NextRunAt = &next
+	}
+
+	if err := s.recurrences.Create(ctx, rec); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// dtstart is the rule anchor in local wall-clock time, so "every Monday 09:00"
+// stays at 09:00 across DST changes. All-day recurrences anchor at local midnight.
+func dtstart(rec *domain.Recurrence) time.Time {
+	if rec.AllDay {
+		y, m, d := rec.StartAt.Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+	}
+	return rec.StartAt.In(time.Local)
+}
+
+// Run creates due recurring cards immediately and then every minute, passing
+// each batch of new cards to onCreated. A failed scan is logged and retried on
+// the next tick. It blocks until ctx is cancelled.
+func (s *RecurrenceService) Run(ctx context.Context, onCreated func([]domain.Card)) error {
+	ticker := time.NewTicker(recurrenceScanInterval)
+	defer ticker.Stop()
+
+	for {
+		cards, err := s.RunDue(ctx)
+		if err != nil {
+			log.Printf("Warning: recurrence scan: %v", err)
+		}
+		if len(cards) > 0 {
+			onCreated(cards)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunDue creates every card whose occurrence has arrived, including occurrences
+// missed while the app was closed (per each recurrence's catch-up policy). A
+// recurrence that fails is logged and skipped, so it cannot hold up the others.
+func (s *RecurrenceService) RunDue(ctx context.Context) ([]domain.Card, error) {
+	recs, err := s.recurrences.GetActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	var created []domain.Card
+	for i := range recs {
+		rec := &recs[i]
+		cards, err := s.runOne(ctx, rec, now)
+		if err != nil {
+			log.Printf("Warning: recurrence %s: %v", rec.ID, err)
+			continue
+		}
+		if len(cards) == 0 {
+			continue
+		}
+		col, err := s.columns.GetByID(ctx, rec.ColumnID)
+		if err != nil {
+			log.Printf("Warning: recurrence %s: %v", rec.ID, err)
+		} else {
+			for _, c := range cards {
+				s.activity.publishCreated(ctx, c.ID, col)
+			}
+		}
+		created = append(created, cards...)
+	}
+	return created, nil
+}
+
+// runOne creates rec's due cards and records its progress in one transaction, so
+// a failure part-way cannot create the same occurrence again on the next tick.
+func (s *RecurrenceService) runOne(ctx context.Context, rec *domain.Recurrence, now time.Time) ([]domain.Card, error) {
+	rule, err := domain.ParseRRule(rec.Rule)
+	if err != nil {
+		return nil, err
+	}
+	var cards []domain.Card
+	err = s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		switch rec.Mode {
+		case domain.RecurrenceOnCompletion:
+			cards, err = s.runOnCompletion(ctx, rec, rule, now)
+		default:
+			cards, err = s.runOnSchedule(ctx, rec, rule, now)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cards, nil
+}
+
+func (s *RecurrenceService) runOnSchedule(ctx context.Context, rec *domain.Recurrence, rule *domain.RRule, now time.Time) ([]domain.Card, error) {
+	if rec.NextRunAt == nil || rec.NextRunAt.After(now) {
+		return nil, nil
+	}
+
+	// Every occurrence from NextRunAt up to now has been missed or is due right now;
+	// only the most recent ones are created.
+	limit := domain.MaxCatchUpCards
+	if rec.CatchUp == domain.CatchUpLatest {
+		limit = 1
+	}
+	due := rule.Between(dtstart(rec), rec.NextRunAt.Add(-time.Nanosecond), now, limit)
+
+	var created []domain.Card
+	for _, occ := range due {
+		card, err := s.createInstance(ctx, rec, occ)
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, *card)
+	}
+
+	rec.NextRunAt = nil
+	if next, ok := rule.Next(dtstart(rec), now); ok {
+		rec.NextRunAt = &next
+	}
+	return created, s.recurrences.UpdateProgress(ctx, rec)
+}
+
+func (s *RecurrenceService) runOnCompletion(ctx context.Context, rec *domain.Recurrence, rule *domain.RRule, now time.Time) ([]domain.Card, error) {
+	done, err := s.isCompleted(ctx, rec.LastCardID)
+	if err != nil || !done {
+		return nil, err
+	}
+
+	// If the planned due date already passed while the previous card was open,
+	// skip ahead to the next occurrence that is still in the future.
+	due := *rec.NextRunAt
+	if due.Before(now) {
+		next, ok := rule.Next(dtstart(rec), now)
+		if !ok {
+			rec.NextRunAt = nil
+			return nil, s.recurrences.UpdateProgress(ctx, rec)
+		}
+		due = next
+	}
+
+	card, err := s.createInstance(ctx, rec, due)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.NextRunAt = nil
+	if next, ok := rule.Next(dtstart(rec), due); ok {
+		rec.NextRunAt = &next
+	}
+	return []domain.Card{*card}, s.recurrences.UpdateProgress(ctx, rec)
+}
+
+// isCompleted reports whether a recurring card is done: deleted, archived, or in
+// a done column.
+func (s *RecurrenceService) isCompleted(ctx context.Context, cardID string) (bool, error) {
+	if cardID == "" {
+		return true, nil
+	}
+	card, err := s.cards.GetByID(ctx, cardID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return card.ArchivedAt != nil || card.CompletedAt != nil, nil
+}
+
+// createInstance adds the card for one occurrence to the recurrence's column, due at occ.
+func (s *RecurrenceService) createInstance(ctx context.Context, rec *domain.Recurrence, occ time.Time) (*domain.Card, error) {
+	tpl := &domain.CardTemplate{
+		BoardID: rec.BoardID, TitlePattern: rec.Title, Description: rec.Description, Priority: rec.Priority,
+	}
+	counter := rec.Occurrences + 1
+	if rec.TemplateID != "" {
+		var err error
+		if tpl, err = s.templates.GetByID(ctx, rec.TemplateID); err != nil {
+			return nil, err
+		}
+		if counter, err = s.templates.NextCounter(ctx, tpl.ID); err != nil {
+			return nil, err
+		}
+	}
+	values := map[string]string{
+		"date":    occ.Format(time.DateOnly),
+		"counter": strconv.Itoa(counter),
+	}
+
+	due := occ.UTC()
+	if rec.AllDay {
+		y, m, d := occ.Date()
+		due = time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	card := &domain.Card{ColumnID: rec.ColumnID, DueAt: &due, AllDay: rec.AllDay}
+	if _, err := s.cardSvc.insertFromTemplate(ctx, tpl, card, values); err != nil {
+		return nil, err
+	}
+
+	rec.LastCardID = card.ID
+	rec.Occurrences++
+	return card, nil
+}
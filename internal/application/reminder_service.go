@@ -0,0 +1,168 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// reminderScanInterval is how often the scheduler looks for due cards.
+const reminderScanInterval = time.Minute
+
+type ReminderService struct {
+	reminders domain.ReminderRepository
+	cards     domain.CardRepository
+	now       func() time.Time
+}
+
+func NewReminderService(reminders domain.ReminderRepository, cards domain.CardRepository) *ReminderService {
+	return &ReminderService{reminders: reminders, cards: cards, now: time.Now}
+}
+
+func (s *ReminderService) GetSettings(ctx context.Context) (domain.ReminderSettings, error) {
+	return s.reminders.GetSettings(ctx)
+}
+
+func (s *ReminderService) UpdateSettings(ctx context.Context, settings domain.ReminderSettings) (domain.ReminderSettings, error) {
+	for _, m := range settings.LeadTimesMinutes {
+		if m <= 0 {
+			return settings, fmt.Errorf("%w: lead times must be positive", domain.ErrValidation)
+		}
+	}
+	if settings.LeadTimesMinutes == nil {
+		settings.LeadTimesMinutes = []int{}
+	}
+	if err := s.reminders.SaveSettings(ctx, settings); err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
+
+// Snooze silences a card's reminders for the given number of minutes,
+// then fires it once more when the snooze expires.
+func (s *ReminderService) Snooze(ctx context.Context, cardID string, minutes int) error {
+	if minutes <= 0 {
+		return fmt.Errorf("%w: snooze duration must be positive", domain.ErrValidation)
+	}
+	if _, err := s.cards.GetByID(ctx, cardID); err != nil {
+		return err
+	}
+	return s.reminders.Snooze(ctx, cardID, s.now().UTC().Add(time.Duration(minutes)*time.Minute))
+}
+
+// Run scans for due cards immediately and then every minute, passing each batch
+// of new reminders to fire. A failed scan is logged and retried on the next tick.
+// It blocks until ctx is cancelled.
+func (s *ReminderService) Run(ctx context.Context, fire func([]domain.Reminder)) error {
+	ticker := time.NewTicker(reminderScanInterval)
+	defer ticker.Stop()
+
+	for {
+		reminders, err := s.Scan(ctx)
+		if err != nil {
+			log.Printf("Warning: reminder scan: %v", err)
+		}
+		if len(reminders) > 0 {
+			fire(reminders)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Scan returns the reminders that are due now and records them as sent,
+// so each card is reminded at most once per lead time and due date. A card whose
+// reminder state cannot be read or written is logged and skipped.
+func (s *ReminderService) Scan(ctx context.Context) ([]domain.Reminder, error) {
+	settings, err := s.reminders.GetSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !settings.Enabled {
+		return nil, nil
+	}
+
+	candidates, err := s.reminders.ListCandidates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snoozes, err := s.reminders.Snoozes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	leads := append([]int(nil), settings.LeadTimesMinutes...)
+	sort.Ints(leads)
+
+	now := s.now().UTC()
+	var out []domain.Reminder
+	for _, c := range candidates {
+		r := domain.Reminder{
+			CardID: c.CardID, CardTitle: c.CardTitle,
+			BoardID: c.BoardID, BoardTitle: c.BoardTitle,
+			DueAt: c.DueAt, AllDay: c.AllDay,
+		}
+
+		if until, ok := snoozes[c.CardID]; ok {
+			if now.Before(until) {
+				continue
+			}
+			if err := s.reminders.ClearSnooze(ctx, c.CardID); err != nil {
+				log.Printf("Warning: reminder for card %s: %v", c.CardID, err)
+				continue
+			}
+			r.Kind = domain.ReminderSnoozed
+			out = append(out, r)
+			continue
+		}
+
+		deadline := domain.DueDeadline(c.DueAt, c.AllDay, time.Local)
+		key, ok := reminderKey(deadline.Sub(now), leads)
+		if !ok {
+			continue
+		}
+		sent, err := s.reminders.WasSent(ctx, c.CardID, key, c.DueAt)
+		if err != nil {
+			log.Printf("Warning: reminder for card %s: %v", c.CardID, err)
+			continue
+		}
+		if sent {
+			continue
+		}
+		if err := s.reminders.MarkSent(ctx, c.CardID, key, c.DueAt, now); err != nil {
+			log.Printf("Warning: reminder for card %s: %v", c.CardID, err)
+			continue
+		}
+
+		if key == domain.ReminderOverdue {
+			r.Kind = domain.ReminderOverdue
+		} else {
+			r.Kind = domain.ReminderDueSoon
+			fmt.Sscanf(key, "lead:%d", &r.LeadMinutes)
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+// reminderKey picks the tightest lead time the remaining duration falls within.
+// leads must be sorted ascending.
+func reminderKey(remaining time.Duration, leads []int) (string, bool) {
+	if remaining <= 0 {
+		return domain.ReminderOverdue, true
+	}
+	for _, m := range leads {
+		if remaining <= time.Duration(m)*time.Minute {
+			return fmt.Sprintf("lead:%d", m), true
+		}
+	}
+	return "", false
+}
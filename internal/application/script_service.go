@@ -0,0 +1,314 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// scriptRunKey marks a context as belonging to a script run. Activity caused by a
+// script does not trigger script hooks again, so hooks cannot loop.
+type scriptRunKey struct{}
+
+// maxPendingHooks is how many activity events may wait for their hooks; events
+// beyond it are dropped with a warning.
+const maxPendingHooks = 100
+
+// hookEvent is an activity event waiting for its hooks to run.
+type hookEvent struct {
+	kind  string
+	event map[string]any
+}
+
+// ScriptService runs user scripts: activity hooks and command palette commands.
+type ScriptService struct {
+	engine   domain.ScriptEngine
+	cards    domain.CardRepository
+	boardSvc *BoardService
+	cardSvc  *CardService
+	fieldSvc *CustomFieldService
+
+	// pending queues activity for Run, so hooks never hold up the triggering request.
+	pending chan hookEvent
+
+	mu sync.Mutex
+	// hookErrors holds the last hook failure per script name.
+	hookErrors map[string]string
+}
+
+// NewScriptService creates the service and subscribes its hooks to feed.
+func NewScriptService(
+	engine domain.ScriptEngine,
+	cards domain.CardRepository,
+	boardSvc *BoardService,
+	cardSvc *CardService,
+	fieldSvc *CustomFieldService,
+	feed *ActivityFeed,
+) *ScriptService {
+	s := &ScriptService{
+		engine: engine, cards: cards, boardSvc: boardSvc, cardSvc: cardSvc, fieldSvc: fieldSvc,
+		pending:    make(chan hookEvent, maxPendingHooks),
+		hookErrors: make(map[string]string),
+	}
+	feed.SubscribeCards(func(ctx context.Context, a domain.CardActivity) {
+		s.enqueue(ctx, a.Kind, map[string]any{
+			"kind": a.Kind, "card_id": a.CardID, "board_id": a.BoardID,
+			"column_id": a.ColumnID, "from_column_id": a.FromColumnID,
+		})
+	})
+	feed.SubscribeBoards(func(ctx context.Context, a domain.BoardActivity) {
+		s.enqueue(ctx, a.Kind, map[string]any{"kind": a.Kind, "board_id": a.BoardID})
+	})
+	return s
+}
+
+// Run calls the hooks of queued activity, one event at a time, until ctx is
+// cancelled. ran is called with the event's board ID after at least one hook ran.
+func (s *ScriptService) Run(ctx context.Context, ran func(boardID string)) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case h := <-s.pending:
+			if s.runHooks(ctx, h.kind, h.event) {
+				boardID, _ := h.event["board_id"].(string)
+				ran(boardID)
+			}
+		}
+	}
+}
+
+// enqueue queues an activity event for Run. Activity caused by a script is skipped.
+func (s *ScriptService) enqueue(ctx context.Context, kind string, event map[string]any) {
+	if ctx.Value(scriptRunKey{}) != nil {
+		return
+	}
+	select {
+	case s.pending <- hookEvent{kind: kind, event: event}:
+	default:
+		log.Printf("Warning: script hooks: queue full, dropping %s", kind)
+	}
+}
+
+// List returns the scripts in the scripts folder.
+func (s *ScriptService) List(ctx context.Context) ([]domain.Script, error) {
+	scripts, err := s.engine.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range scripts {
+		scripts[i].LastError = s.hookErrors[scripts[i].Name]
+	}
+	return scripts, nil
+}
+
+// Commands returns the command palette entries of every script that loads.
+func (s *ScriptService) Commands(ctx context.Context) ([]domain.ScriptCommand, error) {
+	scripts, err := s.engine.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var out []domain.ScriptCommand
+	for _, sc := range scripts {
+		for _, fn := range sc.Functions {
+			if name, ok := strings.CutPrefix(fn, domain.ScriptCommandPrefix); ok && name != "" {
+				out = append(out, domain.ScriptCommand{
+					Script: sc.Name, Function: fn, Label: strings.ReplaceAll(name, "_", " "),
+				})
+			}
+		}
+	}
+	return out, nil
+}
+
+// RunCommand runs a palette command. The function receives a dict with the board
+// and card the user had selected; either may be empty.
+func (s *ScriptService) RunCommand(ctx context.Context, script, fn, boardID, cardID string) (*domain.ScriptResult, error) {
+	if !strings.HasPrefix(fn, domain.ScriptCommandPrefix) {
+		v := domain.NewValidator()
+		v.Add("function", domain.CodeInvalidValue, "function must start with "+domain.ScriptCommandPrefix)
+		return nil, v.Err()
+	}
+	arg := map[string]any{"board_id": boardID, "card_id": cardID}
+	return s.engine.Call(s.scriptContext(ctx), script, fn, arg, s.api())
+}
+
+// runHooks calls on_<kind> in every script that defines it and reports whether any
+// was called. Failures are logged and kept for List; they never affect the change
+// that triggered the hook.
+func (s *ScriptService) runHooks(ctx context.Context, kind string, event map[string]any) bool {
+	scripts, err := s.engine.List(ctx)
+	if err != nil {
+		log.Printf("Warning: script hooks: %v", err)
+		return false
+	}
+	ctx = s.scriptContext(ctx)
+	hook := domain.ScriptHookPrefix + kind
+	ran := false
+	for _, sc := range scripts {
+		if sc.Error != "" || !slices.Contains(sc.Functions, hook) {
+			continue
+		}
+		_, err := s.engine.Call(ctx, sc.Name, hook, event, s.api())
+		ran = true
+		s.mu.Lock()
+		if err != nil {
+			s.hookErrors[sc.Name] = err.Error()
+			log.Printf("Warning: script %s %s: %v", sc.Name, hook, err)
+		} else {
+			delete(s.hookErrors, sc.Name)
+		}
+		s.mu.Unlock()
+	}
+	return ran
+}
+
+func (s *ScriptService) scriptContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, scriptRunKey{}, true)
+}
+
+// api is the kanban module given to scripts. Every call goes through the same
+// services as the UI, so validation and workflows apply to scripts too.
+func (s *ScriptService) api() map[string]domain.ScriptFunc {
+	return map[string]domain.ScriptFunc{
+		"get_board": {Params: []string{"board_id"}, Call: func(ctx context.Context, args map[string]any) (any, error) {
+			boardID, err := stringArg(args, "board_id")
+			if err != nil {
+				return nil, err
+			}
+			return scriptValue(s.boardSvc.GetWithData(ctx, boardID))
+		}},
+		"get_card": {Params: []string{"card_id"}, Call: func(ctx context.Context, args map[string]any) (any, error) {
+			cardID, err := stringArg(args, "card_id")
+			if err != nil {
+				return nil, err
+			}
+			return scriptValue(s.cards.GetByID(ctx, cardID))
+		}},
+		"search_cards": {Params: []string{"board_id", "query"}, Call: func(ctx context.Context, args map[string]any) (any, error) {
+			boardID, err := stringArg(args, "board_id")
+			if err != nil {
+				return nil, err
+			}
+			query, err := stringArg(args, "query")
+			if err != nil {
+				return nil, err
+			}
+			return scriptValue(s.cardSvc.Search(ctx, boardID, query, ""))
+		}},
+		"create_card": {Params: []string{"column_id", "title"}, Call: func(ctx context.Context, args map[string]any) (any, error) {
+			columnID, err := stringArg(args, "column_id")
+			if err != nil {
+				return nil, err
+			}
+			title, err := stringArg(args, "title")
+			if err != nil {
+				return nil, err
+			}
+			return scriptValue(s.cardSvc.Create(ctx, columnID, title))
+		}},
+		"update_card": {
+			Params: []string{"card_id", "title?", "description?", "priority?", "start_date?", "due_at?", "estimate?"},
+			Call: func(ctx context.Context, args map[string]any) (any, error) {
+				cardID, err := stringArg(args, "card_id")
+				if err != nil {
+					return nil, err
+				}
+				var u domain.CardUpdate
+				for name, dst := range map[string]**string{
+					"title": &u.Title, "description": &u.Description, "priority": &u.Priority,
+					"start_date": &u.StartDate, "due_at": &u.DueAt, "estimate": &u.Estimate,
+				} {
+					if _, ok := args[name]; !ok {
+						continue
+					}
+					// None clears a date or estimate.
+					v, err := optionalStringArg(args, name)
+					if err != nil {
+						return nil, err
+					}
+					*dst = &v
+				}
+				return scriptValue(s.cardSvc.Update(ctx, cardID, u))
+			},
+		},
+		"move_card": {Params: []string{"card_id", "column_id", "position?"}, Call: func(ctx context.Context, args map[string]any) (any, error) {
+			cardID, err := stringArg(args, "card_id")
+			if err != nil {
+				return nil, err
+			}
+			columnID, err := stringArg(args, "column_id")
+			if err != nil {
+				return nil, err
+			}
+			pos, ok := args["position"].(int64)
+			if !ok {
+				maxPos, err := s.cards.MaxPosition(ctx, columnID)
+				if err != nil {
+					return nil, err
+				}
+				pos = int64(maxPos + 1000)
+			}
+			if err := s.cardSvc.Move(ctx, cardID, columnID, int(pos), false); err != nil {
+				return nil, err
+			}
+			return scriptValue(s.cards.GetByID(ctx, cardID))
+		}},
+		"set_field": {Params: []string{"card_id", "field_id", "value"}, Call: func(ctx context.Context, args map[string]any) (any, error) {
+			cardID, err := stringArg(args, "card_id")
+			if err != nil {
+				return nil, err
+			}
+			fieldID, err := stringArg(args, "field_id")
+			if err != nil {
+				return nil, err
+			}
+			value := args["value"]
+			if n, ok := value.(int64); ok {
+				value = float64(n)
+			}
+			return scriptValue(s.fieldSvc.SetValue(ctx, cardID, fieldID, value))
+		}},
+	}
+}
+
+// stringArg returns the string argument name.
+func stringArg(args map[string]any, name string) (string, error) {
+	v, ok := args[name].(string)
+	if !ok {
+		return "", fmt.Errorf("%s must be a string", name)
+	}
+	return v, nil
+}
+
+// optionalStringArg returns the string argument name, or "" for None.
+func optionalStringArg(args map[string]any, name string) (string, error) {
+	if args[name] == nil {
+		return "", nil
+	}
+	return stringArg(args, name)
+}
+
+// scriptValue converts a service result to the JSON-like form scripts receive.
+func scriptValue(v any, err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encode script value: %w", err)
+	}
+	var out any
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, fmt.Errorf("decode script value: %w", err)
+	}
+	return out, nil
+}
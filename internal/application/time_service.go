@@ -0,0 +1,313 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// TimeService runs card timers, records manual time entries and reports totals.
+type TimeService struct {
+	entries domain.TimeEntryRepository
+	cards   domain.CardRepository
+	tx      domain.Transactor
+	now     func() time.Time
+	loc     *time.Location
+}
+
+func NewTimeService(entries domain.TimeEntryRepository, cards domain.CardRepository, tx domain.Transactor) *TimeService {
+	return &TimeService{entries: entries, cards: cards, tx: tx, now: time.Now, loc: time.Local}
+}
+
+// withElapsed fills Seconds for a running timer.
+func (s *TimeService) withElapsed(e *domain.TimeEntry) {
+	if e.Running() {
+		e.Seconds = max(0, int64(s.now().Sub(e.StartedAt).Seconds()))
+	}
+}
+
+// StartTimer starts a timer on cardID, stopping whichever timer was running.
+func (s *TimeService) StartTimer(ctx context.Context, cardID, note string) (*domain.TimeEntry, error) {
+	v := domain.NewValidator()
+	v.MaxLength("note", note, domain.MaxTimeNoteLength)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	now := s.now().UTC().Truncate(time.Second)
+	entry := &domain.TimeEntry{ID: uuid.New().String(), CardID: cardID, StartedAt: now, Note: note, CreatedAt: now}
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := s.cards.GetByID(ctx, cardID); err != nil {
+			return err
+		}
+		running, err := s.entries.GetRunning(ctx)
+		switch {
+		case err == nil:
+			if err := s.entries.Stop(ctx, running.ID, now); err != nil {
+				return err
+			}
+		case !errors.Is(err, domain.ErrNotFound):
+			return err
+		}
+		return s.entries.Create(ctx, entry)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// StopTimer stops the running timer and returns it. It returns ErrNotFound when
+// no timer is running.
+func (s *TimeService) StopTimer(ctx context.Context) (*domain.TimeEntry, error) {
+	var stopped *domain.TimeEntry
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		running, err := s.entries.GetRunning(ctx)
+		if err != nil {
+			return err
+		}
+		if err := s.entries.Stop(ctx, running.ID, s.now().UTC().Truncate(time.Second)); err != nil {
+			return err
+		}
+		stopped, err = s.entries.GetByID(ctx, running.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stopped, nil
+}
+
+// RunningTimer returns the running timer, or nil when none is running.
+func (s *TimeService) RunningTimer(ctx context.Context) (*domain.TimeEntry, error) {
+	running, err := s.entries.GetRunning(ctx)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.withElapsed(running)
+	return running, nil
+}
+
+// AddEntry records time worked on cardID outside of a timer. The entry must not
+// overlap another entry on the same card; a running timer counts up to now.
+// Entries on different cards may overlap.
+func (s *TimeService) AddEntry(ctx context.Context, cardID string, in domain.TimeEntryInput) (*domain.TimeEntry, error) {
+	v := domain.NewValidator()
+	started, errStart := time.Parse(time.RFC3339, in.StartedAt)
+	if errStart != nil {
+		v.Add("started_at", domain.CodeInvalidFormat, "started_at must be an RFC3339 date-time")
+	}
+	ended, errEnd := time.Parse(time.RFC3339, in.EndedAt)
+	if errEnd != nil {
+		v.Add("ended_at", domain.CodeInvalidFormat, "ended_at must be an RFC3339 date-time")
+	}
+	if errStart == nil && errEnd == nil {
+		switch d := ended.Sub(started); {
+		case d <= 0:
+			v.Add("ended_at", domain.CodeOutOfOrder, "ended_at must be after started_at")
+		case d > domain.MaxTimeEntryDuration:
+			v.Add("ended_at", domain.CodeInvalidValue, "a time entry must not exceed 24 hours")
+		case ended.After(s.now()):
+			v.Add("ended_at", domain.CodeInvalidValue, "ended_at must not be in the future")
+		}
+	}
+	v.MaxLength("note", in.Note, domain.MaxTimeNoteLength)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	started, ended = started.UTC().Truncate(time.Second), ended.UTC().Truncate(time.Second)
+	entry := &domain.TimeEntry{
+		ID:        uuid.New().String(),
+		CardID:    cardID,
+		StartedAt: started,
+		EndedAt:   &ended,
+		Seconds:   int64(ended.Sub(started).Seconds()),
+		Note:      in.Note,
+		CreatedAt: s.now().UTC(),
+	}
+	err := s.tx.WithinTx(ctx, func(ctx context.Context) error {
+		if _, err := s.cards.GetByID(ctx, cardID); err != nil {
+			return err
+		}
+		existing, err := s.entries.ListByCard(ctx, cardID)
+		if err != nil {
+			return err
+		}
+		for _, e := range existing {
+			end := s.now().UTC()
+			if e.EndedAt != nil {
+				end = *e.EndedAt
+			}
+			if started.Before(end) && e.StartedAt.Before(ended) {
+				v.Add("started_at", domain.CodeInvalidValue, "the entry overlaps another time entry on this card")
+				return v.Err()
+			}
+		}
+		return s.entries.Create(ctx, entry)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// DeleteEntry removes a time entry; deleting the running timer discards it.
+func (s *TimeService) DeleteEntry(ctx context.Context, id string) error {
+	return s.entries.Delete(ctx, id)
+}
+
+// CardLog lists a card's time entries with their total.
+func (s *TimeService) CardLog(ctx context.Context, cardID string) (*domain.CardTimeLog, error) {
+	if _, err := s.cards.GetByID(ctx, cardID); err != nil {
+		return nil, err
+	}
+	entries, err := s.entries.ListByCard(ctx, cardID)
+	if err != nil {
+		return nil, err
+	}
+	log := &domain.CardTimeLog{CardID: cardID, Entries: []domain.TimeEntry{}}
+	for _, e := range entries {
+		s.withElapsed(&e)
+		log.TotalSeconds += e.Seconds
+		log.Entries = append(log.Entries, e)
+	}
+	return log, nil
+}
+
+// boardEntries loads boardID's time entries that started on local days from..to
+// (YYYY-MM-DD, inclusive; either may be empty) along with the titles of their cards.
+func (s *TimeService) boardEntries(ctx context.Context, boardID, from, to string) ([]domain.TimeEntry, map[string]string, error) {
+	v := domain.NewValidator()
+	var start, end time.Time
+	if from != "" {
+		t, err := time.ParseInLocation(time.DateOnly, from, s.loc)
+		if err != nil {
+			v.Add("from", domain.CodeInvalidFormat, "from must be YYYY-MM-DD")
+		}
+		start = t
+	}
+	if to != "" {
+		t, err := time.ParseInLocation(time.DateOnly, to, s.loc)
+		if err != nil {
+			v.Add("to", domain.CodeInvalidFormat, "to must be YYYY-MM-DD")
+		}
+		end = t.AddDate(0, 0, 1)
+	}
+	if !start.IsZero() && !end.IsZero() && !start.Before(end) {
+		v.Add("from", domain.CodeOutOfOrder, "from must not be after to")
+	}
+	if err := v.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	cards, err := s.cards.GetByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, nil, err
+	}
+	titles := make(map[string]string, len(cards))
+	for _, c := range cards {
+		titles[c.ID] = c.Title
+	}
+	entries, err := s.entries.ListByBoard(ctx, boardID, start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range entries {
+		s.withElapsed(&entries[i])
+	}
+	return entries, titles, nil
+}
+
+// Report totals the time logged on boardID's cards between from and to
+// (YYYY-MM-DD, inclusive; either may be empty for an open range).
+func (s *TimeService) Report(ctx context.Context, boardID, from, to string) (*domain.TimeReport, error) {
+	entries, titles, err := s.boardEntries(ctx, boardID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.TimeReport{
+		BoardID: boardID, From: from, To: to,
+		ByCard: []domain.CardTime{}, ByDate: []domain.DateTime{},
+	}
+	byCard := make(map[string]int)
+	for _, e := range entries {
+		report.TotalSeconds += e.Seconds
+
+		i, ok := byCard[e.CardID]
+		if !ok {
+			i = len(report.ByCard)
+			byCard[e.CardID] = i
+			report.ByCard = append(report.ByCard, domain.CardTime{CardID: e.CardID, Title: titles[e.CardID]})
+		}
+		report.ByCard[i].Seconds += e.Seconds
+
+		// Entries arrive oldest first, so each day is either the last one or a new one.
+		date := e.StartedAt.In(s.loc).Format(time.DateOnly)
+		if n := len(report.ByDate); n == 0 || report.ByDate[n-1].Date != date {
+			report.ByDate = append(report.ByDate, domain.DateTime{Date: date})
+		}
+		report.ByDate[len(report.ByDate)-1].Seconds += e.Seconds
+	}
+	sort.SliceStable(report.ByCard, func(i, j int) bool {
+		return report.ByCard[i].Seconds > report.ByCard[j].Seconds
+	})
+	return report, nil
+}
+
+// ExportCSV renders the entries of Report as a timesheet, one row per entry with
+// local times and hours to two decimals. A running timer has an empty end.
+// Titles and notes that a spreadsheet would read as a formula are quoted with '.
+func (s *TimeService) ExportCSV(ctx context.Context, boardID, from, to string) (string, error) {
+	entries, titles, err := s.boardEntries(ctx, boardID, from, to)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"date", "card", "started_at", "ended_at", "hours", "note"})
+	for _, e := range entries {
+		started := e.StartedAt.In(s.loc)
+		ended := ""
+		if e.EndedAt != nil {
+			ended = e.EndedAt.In(s.loc).Format(time.RFC3339)
+		}
+		w.Write([]string{
+			started.Format(time.DateOnly),
+			csvText(titles[e.CardID]),
+			started.Format(time.RFC3339),
+			ended,
+			strconv.FormatFloat(float64(e.Seconds)/3600, 'f', 2, 64),
+			csvText(e.Note),
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", fmt.Errorf("write timesheet: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// csvText prefixes s with ' when it starts with a character that makes spreadsheets
+// evaluate the cell as a formula.
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
@@ -0,0 +1,58 @@
+package application
+
+import (
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+func TestExportCSVQuotesFormulas(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestServices(t)
+	svc.times.loc = time.UTC
+	board, err := svc.boards.Create(ctx, "Timesheet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := svc.boards.GetWithData(ctx, board.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	card, err := svc.cards.Create(ctx, data.Columns[0].Column.ID, "=HYPERLINK(\"x\")")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, note := range []string{"+1 review", "-", "@home", "plain"} {
+		start := time.Date(2026, 1, 5+i, 9, 0, 0, 0, time.UTC)
+		if _, err := svc.times.AddEntry(ctx, card.ID, domain.TimeEntryInput{
+			StartedAt: start.Format(time.RFC3339), EndedAt: start.Add(time.Hour).Format(time.RFC3339), Note: note,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	out, err := svc.times.ExportCSV(ctx, board.ID, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	notes := map[string]bool{}
+	for _, row := range rows[1:] {
+		if want := "'=HYPERLINK(\"x\")"; row[1] != want {
+			t.Errorf("card = %q, want %q", row[1], want)
+		}
+		notes[row[5]] = true
+	}
+	for _, want := range []string{"'+1 review", "'-", "'@home", "plain"} {
+		if !notes[want] {
+			t.Errorf("notes %v lack %q", notes, want)
+		}
+	}
+}
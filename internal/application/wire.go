@@ -6,4 +6,13 @@ var ServiceSet = wire.NewSet(
 	NewBoardService,
 	NewColumnService,
 	NewCardService,
+	NewReminderService,
+	NewRecurrenceService,
+	NewAnalyticsService,
+	NewPersonService,
+	NewTimeService,
+	NewCustomFieldService,
+	NewActivityFeed,
+	NewAutomationService,
+	NewScriptService,
 )
@@ -0,0 +1,91 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// Workflow returns a board's column transition rules.
+func (s *BoardService) Workflow(ctx context.Context, boardID string) (*domain.Workflow, error) {
+	if _, err := s.boards.GetByID(ctx, boardID); err != nil {
+		return nil, err
+	}
+	return s.workflows.GetByBoardID(ctx, boardID)
+}
+
+// SetWorkflow replaces a board's transition rules. transitions maps a column to
+// the columns its cards may move to; columns left out are unrestricted, and an
+// empty map removes every rule.
+func (s *BoardService) SetWorkflow(ctx context.Context, boardID string, transitions map[string][]string) (*domain.Workflow, error) {
+	if _, err := s.boards.GetByID(ctx, boardID); err != nil {
+		return nil, err
+	}
+	cols, err := s.columns.GetByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	onBoard := func(id string) bool {
+		return slices.ContainsFunc(cols, func(c domain.Column) bool { return c.ID == id })
+	}
+
+	v := domain.NewValidator()
+	w := &domain.Workflow{BoardID: boardID, Transitions: make(map[string][]string, len(transitions))}
+	for from, targets := range transitions {
+		if !onBoard(from) {
+			v.Add("transitions", domain.CodeWrongBoard, "column "+from+" is not a column of this board")
+			continue
+		}
+		if len(targets) == 0 {
+			v.Add("transitions", domain.CodeRequired, "a restricted column must allow at least one target")
+			continue
+		}
+		var allowed []string
+		for _, to := range targets {
+			switch {
+			case !onBoard(to):
+				v.Add("transitions", domain.CodeWrongBoard, "column "+to+" is not a column of this board")
+			case to == from:
+				v.Add("transitions", domain.CodeInvalidValue, "a column cannot list itself as a target")
+			case !slices.Contains(allowed, to):
+				allowed = append(allowed, to)
+			}
+		}
+		w.Transitions[from] = allowed
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := s.workflows.Replace(ctx, w); err != nil {
+		return nil, err
+	}
+	return s.workflows.GetByBoardID(ctx, boardID)
+}
+
+// checkTransition returns ErrTransitionNotAllowed, naming the allowed targets,
+// when the board's workflow forbids moving a card from one column to another.
+func (s *CardService) checkTransition(ctx context.Context, from, to *domain.Column) error {
+	w, err := s.workflows.GetByBoardID(ctx, from.BoardID)
+	if err != nil {
+		return err
+	}
+	if w.Allows(from.ID, to.ID) {
+		return nil
+	}
+	cols, err := s.columns.GetByBoardID(ctx, from.BoardID)
+	if err != nil {
+		return err
+	}
+	var allowed []string
+	for _, c := range cols {
+		if slices.Contains(w.Transitions[from.ID], c.ID) {
+			allowed = append(allowed, c.Title)
+		}
+	}
+	return fmt.Errorf("%w: cards in %s can only move to %s, not %s",
+		domain.ErrTransitionNotAllowed, from.Title, strings.Join(allowed, ", "), to.Title)
+}
@@ -0,0 +1,25 @@
+package domain
+
+// Board activity kinds.
+const (
+	BoardCreated = "board_created"
+	BoardDeleted = "board_deleted"
+)
+
+// CardActivity is something that happened to a card, published by CardService once it is saved.
+type CardActivity struct {
+	// Kind is TriggerCardCreated or TriggerCardMoved.
+	Kind     string
+	CardID   string
+	BoardID  string
+	ColumnID string
+	// FromColumnID is the column a moved card left.
+	FromColumnID string
+}
+
+// BoardActivity is something that happened to a board, published by BoardService once it is saved.
+type BoardActivity struct {
+	// Kind is BoardCreated or BoardDeleted.
+	Kind    string
+	BoardID string
+}
@@ -0,0 +1,274 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Reporting intervals for time-series analytics.
+const (
+	IntervalDay  = "day"
+	IntervalWeek = "week"
+)
+
+// MaxSeriesPoints caps how many buckets a single time-series request may produce.
+const MaxSeriesPoints = 1000
+
+// CumulativeFlow is the data behind a cumulative flow diagram.
+//
+// What: For each bucket in a date range, how many cards sat in each column of a board.
+// Why: Widening or flattening bands show bottlenecks and stalled work at a glance.
+// When: Computed on request from card history; never stored.
+type CumulativeFlow struct {
+	BoardID  string `json:"board_id"`
+	Interval string `json:"interval"`
+	// Columns lists current columns in board order, then deleted columns that held cards in range.
+	Columns []FlowColumn `json:"columns"`
+	Points  []FlowPoint  `json:"points"`
+}
+
+// FlowColumn identifies one band of a cumulative flow diagram.
+type FlowColumn struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	// Deleted is true for columns that no longer exist; Title is the last title history saw.
+	Deleted bool `json:"deleted"`
+}
+
+// FlowPoint is one bucket of a cumulative flow diagram. Counts is aligned with
+// CumulativeFlow.Columns and holds the number of cards in each column at the end of the bucket.
+type FlowPoint struct {
+	Date   string `json:"date"`
+	Counts []int  `json:"counts"`
+}
+
+// FlowMetricsFilter narrows flow metrics to a date range and card attributes.
+// From and To are YYYY-MM-DD (inclusive); empty From means 90 days before To,
+// empty To means today. Empty Priorities means all priorities. A card must satisfy
+// every entry of Fields, matched as in board filtering.
+type FlowMetricsFilter struct {
+	From       string        `json:"from"`
+	To         string        `json:"to"`
+	Priorities []string      `json:"priorities"`
+	Fields     []FieldFilter `json:"fields"`
+}
+
+// DefaultMetricsDays is the length of the reporting range when From is omitted.
+const DefaultMetricsDays = 90
+
+// Percentiles summarises a distribution of durations in days.
+type Percentiles struct {
+	P50 float64 `json:"p50"`
+	P85 float64 `json:"p85"`
+	P95 float64 `json:"p95"`
+}
+
+// CardFlowTime is the lead and cycle time of one completed card.
+//
+// What: When a card was created, started (first reached an active or done column) and
+// completed (last reached a done column), with the durations between them in days.
+// Why: Individual outliers are what retros talk about; percentiles alone hide them.
+// When: Produced for cards completed within the requested range.
+type CardFlowTime struct {
+	CardID        string    `json:"card_id"`
+	Title         string    `json:"title"`
+	Priority      string    `json:"priority"`
+	CreatedAt     time.Time `json:"created_at"`
+	StartedAt     time.Time `json:"started_at"`
+	CompletedAt   time.Time `json:"completed_at"`
+	LeadTimeDays  float64   `json:"lead_time_days"`
+	CycleTimeDays float64   `json:"cycle_time_days"`
+	Estimate      *float64  `json:"estimate"`
+}
+
+// ThroughputPoint is the number of cards completed in the week starting WeekStart (a Monday),
+// and the sum of their estimates.
+type ThroughputPoint struct {
+	WeekStart string  `json:"week_start"`
+	Count     int     `json:"count"`
+	Points    float64 `json:"points"`
+}
+
+// AgingCard is an open card that has started but not completed.
+type AgingCard struct {
+	CardID    string    `json:"card_id"`
+	Title     string    `json:"title"`
+	Priority  string    `json:"priority"`
+	ColumnID  string    `json:"column_id"`
+	StartedAt time.Time `json:"started_at"`
+	AgeDays   float64   `json:"age_days"`
+}
+
+// FlowMetrics is the result of a flow metrics query for one board.
+type FlowMetrics struct {
+	BoardID       string            `json:"board_id"`
+	From          string            `json:"from"`
+	To            string            `json:"to"`
+	StartColumnID string            `json:"start_column_id"`
+	DoneColumnID  string            `json:"done_column_id"`
+	Cards         []CardFlowTime    `json:"cards"`
+	LeadTime      Percentiles       `json:"lead_time"`
+	CycleTime     Percentiles       `json:"cycle_time"`
+	Throughput    []ThroughputPoint `json:"throughput"`
+	// AgingWIP lists open, started cards oldest first, regardless of the date range.
+	AgingWIP []AgingCard `json:"aging_wip"`
+}
+
+// Monte Carlo forecast limits and defaults. Forecasts run synchronously, so the caps
+// keep the worst case (MaxSimulations trials of MaxForecastDays draws) well under a
+// second.
+const (
+	DefaultSimulations = 10000
+	MaxSimulations     = 20000
+	// MaxForecastDays is the simulation horizon; trials that have not finished by then are
+	// reported as incomplete.
+	MaxForecastDays = 1830
+)
+
+// ForecastConfidences are the confidence levels reported by forecasts, in percent.
+var ForecastConfidences = []int{50, 85, 95}
+
+// Forecast units: count completed cards, or sum their estimates.
+const (
+	ForecastUnitCards  = "cards"
+	ForecastUnitPoints = "points"
+)
+
+// CompletionForecast answers "when will the remaining work be done?".
+//
+// What: The distribution of finish dates over many simulated futures, each built by
+// sampling days from the board's recent daily throughput.
+// Why: A single average hides risk; a distribution lets people commit at a chosen confidence.
+// When: Computed on request; never stored. The same Seed reproduces the same result.
+type CompletionForecast struct {
+	BoardID string `json:"board_id"`
+	Unit    string `json:"unit"`
+	// Remaining is the work left, in cards or estimate points depending on Unit.
+	Remaining   float64 `json:"remaining"`
+	Simulations int     `json:"simulations"`
+	Seed        int64   `json:"seed"`
+	// HistoryDays is how many past days of throughput were sampled.
+	HistoryDays  int               `json:"history_days"`
+	Distribution []DateProbability `json:"distribution"`
+	Confidence   []DateConfidence  `json:"confidence"`
+	// Incomplete counts trials that had not finished within MaxForecastDays.
+	Incomplete int `json:"incomplete"`
+}
+
+// DateProbability is the share of trials finishing on Date, and on or before it.
+type DateProbability struct {
+	Date        string  `json:"date"`
+	Probability float64 `json:"probability"`
+	Cumulative  float64 `json:"cumulative"`
+}
+
+// DateConfidence is the date by which the work finishes in Confidence percent of trials.
+// Date is empty when that confidence is not reached within the horizon.
+type DateConfidence struct {
+	Confidence int    `json:"confidence"`
+	Date       string `json:"date"`
+}
+
+// CardsForecast answers "how many cards (or points) will be done by Date?".
+type CardsForecast struct {
+	BoardID      string             `json:"board_id"`
+	Unit         string             `json:"unit"`
+	Date         string             `json:"date"`
+	Simulations  int                `json:"simulations"`
+	Seed         int64              `json:"seed"`
+	HistoryDays  int                `json:"history_days"`
+	Distribution []CountProbability `json:"distribution"`
+	Confidence   []CountConfidence  `json:"confidence"`
+}
+
+// CountProbability is the share of trials completing exactly Amount, and at least that much.
+type CountProbability struct {
+	Amount      float64 `json:"amount"`
+	Probability float64 `json:"probability"`
+	AtLeast     float64 `json:"at_least"`
+}
+
+// CountConfidence is the amount completed in at least Confidence percent of trials.
+type CountConfidence struct {
+	Confidence int     `json:"confidence"`
+	Amount     float64 `json:"amount"`
+}
+
+// BoardStats is a summary of a board's current state and recent activity.
+//
+// What: Card counts by column and priority, due-date health, the oldest open card,
+// and how many cards were created or completed recently.
+// Why: A dashboard needs these numbers quickly, without loading every card.
+// When: Computed on request with aggregate queries. Archived cards are excluded
+// everywhere except the created/completed activity counts; cards in done columns
+// are completed rather than open.
+type BoardStats struct {
+	BoardID    string        `json:"board_id"`
+	TotalCards int           `json:"total_cards"`
+	OpenCards  int           `json:"open_cards"`
+	ByColumn   []ColumnCount `json:"by_column"`
+	// ByPriority counts cards per level of the board's scheme, most urgent first.
+	ByPriority []PriorityCount `json:"by_priority"`
+	// Overdue, DueThisWeek and NoDueDate count open cards only. The week ends on Sunday.
+	Overdue        int           `json:"overdue"`
+	DueThisWeek    int           `json:"due_this_week"`
+	NoDueDate      int           `json:"no_due_date"`
+	OldestOpenCard *CardSummary  `json:"oldest_open_card"`
+	Created        ActivityCount `json:"created"`
+	Completed      ActivityCount `json:"completed"`
+	// ByAssignee breaks open cards down per person, busiest first. A card with several
+	// assignees counts for each of them; UnassignedOpen counts open cards with none.
+	ByAssignee     []AssigneeLoad `json:"by_assignee"`
+	UnassignedOpen int            `json:"unassigned_open"`
+}
+
+// PriorityCount is the number of cards at one priority level.
+type PriorityCount struct {
+	Key   string `json:"key"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+	Count int    `json:"count"`
+}
+
+// AssigneeLoad is one person's share of a board's open cards.
+type AssigneeLoad struct {
+	PersonID string `json:"person_id"`
+	Name     string `json:"name"`
+	Open     int    `json:"open"`
+	Overdue  int    `json:"overdue"`
+}
+
+// ColumnCount is the number of cards in one column.
+type ColumnCount struct {
+	ColumnID string `json:"column_id"`
+	Title    string `json:"title"`
+	Count    int    `json:"count"`
+}
+
+// CardSummary identifies a card without its full contents.
+type CardSummary struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	ColumnID  string    `json:"column_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ActivityCount counts events in the trailing 7 and 30 days.
+type ActivityCount struct {
+	Last7Days  int `json:"last_7_days"`
+	Last30Days int `json:"last_30_days"`
+}
+
+// StatsCutoffs are the instants board statistics are measured against.
+type StatsCutoffs struct {
+	Now time.Time
+	// Today and WeekEnd are local midnights: the start of today and of next Monday.
+	Today   time.Time
+	WeekEnd time.Time
+}
+
+// StatsRepository computes board statistics with aggregate queries.
+type StatsRepository interface {
+	// BoardStats counts cards in done columns as completed.
+	BoardStats(ctx context.Context, boardID string, at StatsCutoffs) (*BoardStats, error)
+}
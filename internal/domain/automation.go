@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Automation trigger kinds.
+const (
+	// TriggerCardCreated fires when a card is created, optionally only in Trigger.ColumnID.
+	TriggerCardCreated = "card_created"
+	// TriggerCardMoved fires when a card moves to another column, optionally only into Trigger.ColumnID.
+	TriggerCardMoved = "card_moved"
+	// TriggerDuePassed fires once per due date when an open card becomes overdue,
+	// optionally only while it sits in Trigger.ColumnID.
+	TriggerDuePassed = "due_passed"
+)
+
+// Automation condition kinds.
+const (
+	// ConditionColumn holds when the card is in ColumnID.
+	ConditionColumn = "column"
+	// ConditionPriority holds when the card's priority matches Value, a key or "key+".
+	ConditionPriority = "priority"
+	// ConditionField holds when the card's value for FieldID matches Value, as in a FieldFilter.
+	ConditionField = "field"
+	// ConditionTitleContains holds when the card title contains Value, ignoring case.
+	ConditionTitleContains = "title_contains"
+)
+
+// Automation action kinds.
+const (
+	// ActionSetPriority sets the card's priority to the key in Value.
+	ActionSetPriority = "set_priority"
+	// ActionMoveToColumn moves the card to the end of ColumnID, following the board's workflow.
+	ActionMoveToColumn = "move_to_column"
+	// ActionSetField sets the card's value for FieldID to Value.
+	ActionSetField = "set_field"
+	// ActionAddFieldOption adds the option in Value to the card's multi-select FieldID,
+	// which makes a multi-select field work as a set of labels.
+	ActionAddFieldOption = "add_field_option"
+)
+
+// Automation run statuses.
+const (
+	AutomationSucceeded   = "succeeded"
+	AutomationFailed      = "failed"
+	AutomationLoopBlocked = "loop_blocked"
+)
+
+// Automation limits.
+const (
+	MaxAutomationNameLength = 100
+	MaxAutomationConditions = 10
+	MaxAutomationActions    = 10
+	// MaxAutomationDepth is how many rules may fire in a chain, each reacting to the
+	// previous one's actions, before the next is blocked.
+	MaxAutomationDepth = 5
+	// MaxAutomationRuns is how many run log entries are kept per board.
+	MaxAutomationRuns = 500
+)
+
+// AutomationRule reacts to card events on a board.
+//
+// What: A trigger, conditions that must all hold, and actions run in order,
+// e.g. "when a card moves to 完成, set priority low".
+// Why: Teams repeat the same follow-up edits by hand after routine moves.
+// When: Edited from the board settings; evaluated by AutomationService after every
+// card create and move, and by its due-date scanner. Actions run through the same
+// services as user edits, so validation and workflows apply. A rule whose column
+// or field was deleted fails at run time and the failure is logged.
+type AutomationRule struct {
+	ID         string                `json:"id"`
+	BoardID    string                `json:"board_id"`
+	Name       string                `json:"name"`
+	Enabled    bool                  `json:"enabled"`
+	Trigger    AutomationTrigger     `json:"trigger"`
+	Conditions []AutomationCondition `json:"conditions"`
+	Actions    []AutomationAction    `json:"actions"`
+	CreatedAt  time.Time             `json:"created_at"`
+	UpdatedAt  time.Time             `json:"updated_at"`
+}
+
+// AutomationRuleInput carries the user-editable fields of an AutomationRule.
+type AutomationRuleInput struct {
+	Name       string                `json:"name"`
+	Enabled    bool                  `json:"enabled"`
+	Trigger    AutomationTrigger     `json:"trigger"`
+	Conditions []AutomationCondition `json:"conditions"`
+	Actions    []AutomationAction    `json:"actions"`
+}
+
+// AutomationTrigger is the event that starts a rule. An empty ColumnID matches any column.
+type AutomationTrigger struct {
+	Kind     string `json:"kind"`
+	ColumnID string `json:"column_id,omitempty"`
+}
+
+// AutomationCondition is a check on the card a rule fired for; which fields apply depends on Kind.
+type AutomationCondition struct {
+	Kind     string `json:"kind"`
+	ColumnID string `json:"column_id,omitempty"`
+	FieldID  string `json:"field_id,omitempty"`
+	Value    any    `json:"value,omitempty"`
+}
+
+// AutomationAction is one change a rule makes to the card; which fields apply depends on Kind.
+type AutomationAction struct {
+	Kind     string `json:"kind"`
+	ColumnID string `json:"column_id,omitempty"`
+	FieldID  string `json:"field_id,omitempty"`
+	Value    any    `json:"value,omitempty"`
+}
+
+// AutomationRun is an execution log entry: one rule firing for one card.
+type AutomationRun struct {
+	ID       string `json:"id"`
+	RuleID   string `json:"rule_id"`
+	RuleName string `json:"rule_name"`
+	BoardID  string `json:"board_id"`
+	CardID   string `json:"card_id"`
+	Trigger  string `json:"trigger"`
+	Status   string `json:"status"`
+	// Message explains a failed or blocked run.
+	Message string    `json:"message"`
+	RanAt   time.Time `json:"ran_at"`
+}
+
+// AutomationRepository defines persistence for automation rules and their run log.
+type AutomationRepository interface {
+	GetByBoardID(ctx context.Context, boardID string) ([]AutomationRule, error)
+	GetByID(ctx context.Context, id string) (*AutomationRule, error)
+	// ListEnabled returns the enabled rules with the given trigger kind on every board.
+	ListEnabled(ctx context.Context, triggerKind string) ([]AutomationRule, error)
+	Create(ctx context.Context, r *AutomationRule) error
+	Update(ctx context.Context, r *AutomationRule) error
+	Delete(ctx context.Context, id string) error
+	// LogRun appends to the run log, keeping the newest MaxAutomationRuns per board.
+	LogRun(ctx context.Context, run *AutomationRun) error
+	// Runs returns a board's newest run log entries first.
+	Runs(ctx context.Context, boardID string, limit int) ([]AutomationRun, error)
+	// DueFired reports whether a due_passed rule already fired for the card's current due date.
+	DueFired(ctx context.Context, ruleID, cardID string, due time.Time) (bool, error)
+	MarkDueFired(ctx context.Context, ruleID, cardID string, due time.Time) error
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"slices"
 	"time"
 )
 
@@ -11,10 +12,19 @@ import (
 // Why: Users need isolated workspaces to manage different projects or workflows independently.
 // When: Created explicitly by the user; deleted when the user removes it (cascades to columns and cards).
 type Board struct {
-	ID        string    `json:"id"`
-	Title     string    `json:"title"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	// StartColumnID and DoneColumnID mark where work begins and ends. Setting them
+	// reclassifies the board's column kinds, which flow metrics read; empty means the
+	// default: the first active and the first done column.
+	StartColumnID string `json:"start_column_id"`
+	DoneColumnID  string `json:"done_column_id"`
+	// EstimateScale is the kind of scale card estimates use (see EstimateScales).
+	EstimateScale string `json:"estimate_scale"`
+	// Priorities is the board's priority scheme, lowest weight first.
+	Priorities []PriorityLevel `json:"priorities"`
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
 }
 
 // BoardRepository defines persistence operations for boards.
@@ -24,4 +34,65 @@ type BoardRepository interface {
 	Create(ctx context.Context, board *Board) error
 	Update(ctx context.Context, board *Board) error
 	Delete(ctx context.Context, id string) error
+	// SetFlowColumns stores the board's start and done columns; empty clears one.
+	SetFlowColumns(ctx context.Context, id, startColumnID, doneColumnID string) error
+	// SetEstimateScale changes the scale kind; stored card estimates are left as they are.
+	SetEstimateScale(ctx context.Context, id, kind string) error
+	// SetPriorities replaces the board's priority scheme. remap moves cards, card
+	// templates and recurrences from each removed key to its replacement.
+	SetPriorities(ctx context.Context, id string, levels []PriorityLevel, remap map[string]string) error
+}
+
+// FlowColumns resolves the board's start and done columns from cols (ordered by
+// position), applying the defaults for unset or stale IDs: the first active and the
+// first done column, or the second and the last column when no column has that kind.
+// Both are nil when cols is empty.
+func (b *Board) FlowColumns(cols []Column) (start, done *Column) {
+	if len(cols) == 0 {
+		return nil, nil
+	}
+	start, done = &cols[0], &cols[len(cols)-1]
+	if len(cols) > 1 {
+		start = &cols[1]
+	}
+	if i := slices.IndexFunc(cols, func(c Column) bool { return c.Kind == ColumnKindActive }); i >= 0 {
+		start = &cols[i]
+	}
+	if i := slices.IndexFunc(cols, func(c Column) bool { return c.Kind == ColumnKindDone }); i >= 0 {
+		done = &cols[i]
+	}
+	for i := range cols {
+		switch cols[i].ID {
+		case b.StartColumnID:
+			start = &cols[i]
+		case b.DoneColumnID:
+			done = &cols[i]
+		}
+	}
+	return start, done
+}
+
+// InferColumnKinds sets the kind of each of cols (ordered by position) from the
+// board's flow columns: columns before the start column are backlog, the done column
+// and those after it are done, the rest are active. A board's only column is active.
+// Kinds already set on cols count towards the flow defaults, so clear them first to
+// classify by position alone.
+func (b *Board) InferColumnKinds(cols []Column) {
+	start, done := b.FlowColumns(cols)
+	if start == nil {
+		return
+	}
+	startPos, donePos := start.Position, done.Position
+	for i := range cols {
+		switch {
+		case len(cols) == 1:
+			cols[i].Kind = ColumnKindActive
+		case cols[i].Position >= donePos:
+			cols[i].Kind = ColumnKindDone
+		case cols[i].Position < startPos:
+			cols[i].Kind = ColumnKindBacklog
+		default:
+			cols[i].Kind = ColumnKindActive
+		}
+	}
 }
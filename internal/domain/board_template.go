@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// BoardTemplate is a reusable blueprint for new boards.
+//
+// What: A named set of columns, plus optional starter cards, that a board can be created from.
+// Templates saved from a board also carry its priority levels, custom fields, workflow,
+// estimate scale and flow columns. Columns have no WIP limit, so templates carry none;
+// custom fields stand in for labels.
+// Why: Teams run the same workflows (Scrum, bug triage, GTD) repeatedly and shouldn't rebuild columns by hand.
+// When: Built-in templates ship with the app; user templates are saved from an existing board and deleted explicitly.
+type BoardTemplate struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	BuiltIn     bool             `json:"built_in"`
+	Columns     []TemplateColumn `json:"columns"`
+	Cards       []TemplateCard   `json:"cards"`
+	// Priorities is the board's priority scheme; empty means DefaultPriorities.
+	Priorities []PriorityLevel `json:"priorities,omitempty"`
+	// Fields are the board's custom field definitions, in board order.
+	Fields []CustomFieldInput `json:"fields,omitempty"`
+	// Transitions is the board's workflow keyed by column index: for each restricted
+	// column, the indexes of the columns its cards may move to.
+	Transitions map[int][]int `json:"transitions,omitempty"`
+	// EstimateScale is the board's estimate scale kind; empty means fibonacci.
+	EstimateScale string `json:"estimate_scale,omitempty"`
+	// StartColumn and DoneColumn are the indexes of the board's explicitly chosen
+	// flow columns (see Board.StartColumnID); nil keeps the default.
+	StartColumn *int      `json:"start_column,omitempty"`
+	DoneColumn  *int      `json:"done_column,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TemplateColumn is a column in a BoardTemplate, in board order. An empty Kind is
+// inferred from the column's position (see Board.InferColumnKinds).
+type TemplateColumn struct {
+	Title string `json:"title"`
+	Kind  string `json:"kind,omitempty"`
+}
+
+// TemplateCard is a starter card placed in the column at ColumnIndex.
+type TemplateCard struct {
+	ColumnIndex int    `json:"column_index"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Priority    string `json:"priority"`
+	// FieldValues holds the card's custom field values keyed by index into BoardTemplate.Fields.
+	FieldValues map[int]any `json:"field_values,omitempty"`
+}
+
+// BoardTemplateRepository defines persistence operations for user-defined board templates.
+// Built-in templates live in code and are never stored.
+type BoardTemplateRepository interface {
+	GetAll(ctx context.Context) ([]BoardTemplate, error)
+	GetByID(ctx context.Context, id string) (*BoardTemplate, error)
+	Create(ctx context.Context, tpl *BoardTemplate) error
+	Delete(ctx context.Context, id string) error
+}
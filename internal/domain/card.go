@@ -7,19 +7,31 @@ import (
 
 // Card represents a task or work item within a column.
 //
-// What: A movable unit of work with title, description, priority, and optional due date.
+// What: A movable unit of work with title, description, priority, and optional start and due dates.
 // Why: Cards are the core interaction object — users create, edit, drag, and track them across columns.
 // When: Created by the user inside a column; moved between columns via drag-and-drop; deleted explicitly.
+//
+// When AllDay is set, StartDate and DueAt are calendar days carried as midnight UTC;
+// only their date part is meaningful.
 type Card struct {
 	ID          string     `json:"id"`
 	ColumnID    string     `json:"column_id"`
 	Title       string     `json:"title"`
 	Description string     `json:"description"`
 	Priority    string     `json:"priority"`
-	DueDate     *time.Time `json:"due_date"`
+	StartDate   *time.Time `json:"start_date"`
+	DueAt       *time.Time `json:"due_at"`
+	AllDay      bool       `json:"all_day"`
+	Estimate    *float64   `json:"estimate"`
 	Position    int        `json:"position"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
+	ArchivedAt  *time.Time `json:"archived_at"`
+	// CompletedAt is when the card entered a done column; nil while it is in any other.
+	CompletedAt *time.Time `json:"completed_at"`
+	AssigneeIDs []string   `json:"assignee_ids"`
+	// CustomFields holds the card's custom field values keyed by field ID.
+	CustomFields map[string]any `json:"custom_fields"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
 }
 
 // CardUpdate carries partial update fields for a card.
@@ -28,21 +40,41 @@ type Card struct {
 // What: A value object representing a partial update request (PATCH semantics).
 // Why: Allows the frontend to update individual fields (e.g. only priority) without sending the full card.
 // When: Sent from the CardDetail panel whenever the user edits a single field.
+//
+// StartDate and DueAt accept "YYYY-MM-DD" (all-day) or RFC3339 (timed); an empty string clears the date.
+// Estimate accepts a label or number on the board's estimate scale; an empty string clears it.
 type CardUpdate struct {
 	Title       *string `json:"title"`
 	Description *string `json:"description"`
 	Priority    *string `json:"priority"`
-	DueDate     *string `json:"due_date"`
+	StartDate   *string `json:"start_date"`
+	DueAt       *string `json:"due_at"`
+	Estimate    *string `json:"estimate"`
 }
 
 // CardRepository defines persistence operations for cards.
 type CardRepository interface {
 	GetByColumnID(ctx context.Context, columnID string) ([]Card, error)
+	GetByID(ctx context.Context, id string) (*Card, error)
+	// GetByBoardID returns every card on a board, including archived ones.
+	GetByBoardID(ctx context.Context, boardID string) ([]Card, error)
 	Create(ctx context.Context, card *Card) error
 	Update(ctx context.Context, id string, updates CardUpdate) (*Card, error)
 	Delete(ctx context.Context, id string) error
 	Move(ctx context.Context, id, targetColumnID string, newPosition int) error
 	MoveAllToColumn(ctx context.Context, fromColumnID, toColumnID string) error
+	// SyncCompleted brings completed_at on a column's cards in line with its kind:
+	// stamped with at when the column is done, cleared otherwise.
+	SyncCompleted(ctx context.Context, columnID string, at time.Time) error
 	MaxPosition(ctx context.Context, columnID string) (int, error)
-	Search(ctx context.Context, boardID, query string) ([]Card, error)
+	// Archive hides a card from its column without deleting it.
+	Archive(ctx context.Context, id string, at time.Time) error
+	// Search matches title and description, most urgent priority first. A non-empty
+	// assigneeID keeps only that person's cards, or unassigned cards for AssigneeNone.
+	Search(ctx context.Context, boardID, query, assigneeID string) ([]Card, error)
+	// SetAssignees replaces a card's assignees with personIDs, in order.
+	SetAssignees(ctx context.Context, cardID string, personIDs []string) error
+	// Restore writes back a snapshot of a card, re-inserting it if it was deleted.
+	// Assignees and field values whose person or field no longer exists are dropped.
+	Restore(ctx context.Context, card *Card) error
 }
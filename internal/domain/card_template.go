@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// CardTemplate is a reusable blueprint for a recurring kind of card on a board.
+//
+// What: A title pattern, description skeleton, default priority, labels and checklist,
+// with {{placeholders}}.
+// Why: Teams create the same "Release checklist" or "Bug report" cards repeatedly.
+// When: Defined per board by the user; instantiated into a column via CreateCardFromTemplate.
+//
+// Labels are options of the board's multi-select fields, as in quick-add. Checklist
+// items are appended to the card's description as a Markdown task list. Counter is
+// the last value substituted for {{counter}}.
+type CardTemplate struct {
+	ID           string    `json:"id"`
+	BoardID      string    `json:"board_id"`
+	Name         string    `json:"name"`
+	TitlePattern string    `json:"title_pattern"`
+	Description  string    `json:"description"`
+	Priority     string    `json:"priority"`
+	Labels       []string  `json:"labels"`
+	Checklist    []string  `json:"checklist"`
+	Counter      int       `json:"counter"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// CardTemplateInput carries the user-editable fields of a CardTemplate.
+type CardTemplateInput struct {
+	Name         string   `json:"name"`
+	TitlePattern string   `json:"title_pattern"`
+	Description  string   `json:"description"`
+	Priority     string   `json:"priority"`
+	Labels       []string `json:"labels"`
+	Checklist    []string `json:"checklist"`
+}
+
+// ChecklistDescription returns the description skeleton followed by the checklist as
+// a Markdown task list, as a card created from the template starts out.
+func (t *CardTemplate) ChecklistDescription() string {
+	var b strings.Builder
+	b.WriteString(t.Description)
+	for i, item := range t.Checklist {
+		switch {
+		case i > 0:
+			b.WriteString("\n")
+		case t.Description != "":
+			b.WriteString("\n\n")
+		}
+		b.WriteString("- [ ] " + item)
+	}
+	return b.String()
+}
+
+// CardTemplateRepository defines persistence operations for card templates.
+type CardTemplateRepository interface {
+	GetByBoardID(ctx context.Context, boardID string) ([]CardTemplate, error)
+	GetByID(ctx context.Context, id string) (*CardTemplate, error)
+	Create(ctx context.Context, tpl *CardTemplate) error
+	Update(ctx context.Context, tpl *CardTemplate) error
+	Delete(ctx context.Context, id string) error
+	// NextCounter atomically increments the template's counter and returns the new value.
+	NextCounter(ctx context.Context, id string) (int, error)
+}
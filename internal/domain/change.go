@@ -0,0 +1,20 @@
+package domain
+
+import "context"
+
+// ExternalChange describes writes to the database made by another process.
+//
+// What: The set of boards whose data changed outside this application instance.
+// Why: The CLI or a second app instance can write to the same SQLite file; open boards must reload to stay accurate.
+// When: Published by a ChangeWatcher whenever it detects a commit from another connection.
+type ExternalChange struct {
+	BoardIDs []string `json:"board_ids"`
+	// All is set when the affected boards could not be identified and every board should reload.
+	All bool `json:"all"`
+}
+
+// ChangeWatcher detects external writes to the underlying store.
+type ChangeWatcher interface {
+	// Watch blocks until ctx is cancelled, calling onChange for each detected external write.
+	Watch(ctx context.Context, onChange func(ExternalChange)) error
+}
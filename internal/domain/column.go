@@ -5,15 +5,28 @@ import (
 	"time"
 )
 
+// Column kinds: the stage of work a column stands for.
+const (
+	ColumnKindBacklog = "backlog"
+	ColumnKindActive  = "active"
+	ColumnKindDone    = "done"
+)
+
+// ColumnKinds lists the column kinds in workflow order.
+var ColumnKinds = []string{ColumnKindBacklog, ColumnKindActive, ColumnKindDone}
+
 // Column represents a workflow stage within a board (e.g. "待辦", "進行中", "完成").
 //
 // What: A named vertical lane that holds an ordered list of cards.
 // Why: Columns visualize workflow stages so users can track card progression at a glance.
 // When: Auto-created (3 defaults) with a new board; user can add, rename, reorder, or delete.
+// Kind says whether the stage is backlog, active or done; cards entering a done column
+// are stamped with Card.CompletedAt.
 type Column struct {
 	ID        string    `json:"id"`
 	BoardID   string    `json:"board_id"`
 	Title     string    `json:"title"`
+	Kind      string    `json:"kind"`
 	Position  int       `json:"position"`
 	CreatedAt time.Time `json:"created_at"`
 }
@@ -29,4 +42,6 @@ type ColumnRepository interface {
 	CountByBoardID(ctx context.Context, boardID string) (int, error)
 	MaxPosition(ctx context.Context, boardID string) (int, error)
 	UpdatePosition(ctx context.Context, id string, position int) error
+	// SetKind changes a column's kind; its cards' completed_at is left as it is.
+	SetKind(ctx context.Context, id, kind string) error
 }
@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Custom field types.
+const (
+	FieldText        = "text"
+	FieldNumber      = "number"
+	FieldDate        = "date"
+	FieldSelect      = "select"
+	FieldMultiSelect = "multi_select"
+	FieldCheckbox    = "checkbox"
+	FieldURL         = "url"
+)
+
+// Custom field limits.
+const (
+	MaxFieldNameLength   = 100
+	MaxFieldOptions      = 100
+	MaxFieldOptionLength = 100
+	MaxFieldTextLength   = 1000
+	MaxFieldURLLength    = 2000
+)
+
+// CustomField is a board-specific piece of card metadata.
+//
+// What: A named, typed field such as "Customer" (text) or "Environment" (select).
+// Options lists the allowed values for select and multi_select fields.
+// Why: Boards track different things; a fixed card schema cannot cover them all.
+// When: Defined per board from the board settings; cards hold at most one value per field,
+// keyed by field ID in Card.CustomFields. Deleting a field deletes its values.
+type CustomField struct {
+	ID        string    `json:"id"`
+	BoardID   string    `json:"board_id"`
+	Name      string    `json:"name"`
+	Type      string    `json:"type"`
+	Options   []string  `json:"options"`
+	Position  int       `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CustomFieldInput carries the user-editable fields of a CustomField.
+// Type cannot be changed once the field exists.
+type CustomFieldInput struct {
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Options []string `json:"options"`
+}
+
+// FieldFilter narrows cards by a custom field value. Text and URL fields match
+// case-insensitive substrings, multi-select fields match cards that include Value,
+// other types match exactly. A nil Value matches cards without a value (an unchecked
+// checkbox counts as no value).
+type FieldFilter struct {
+	FieldID string `json:"field_id"`
+	Value   any    `json:"value"`
+}
+
+// CustomFieldRepository defines persistence operations for custom fields and their values.
+type CustomFieldRepository interface {
+	GetByBoardID(ctx context.Context, boardID string) ([]CustomField, error)
+	GetByID(ctx context.Context, id string) (*CustomField, error)
+	Create(ctx context.Context, f *CustomField) error
+	Update(ctx context.Context, f *CustomField) error
+	Delete(ctx context.Context, id string) error
+	// SetValue stores a card's value for a field; a nil value removes it.
+	SetValue(ctx context.Context, cardID, fieldID string, value any) error
+	// Values returns every stored value of a field, keyed by card ID.
+	Values(ctx context.Context, fieldID string) (map[string]any, error)
+}
+
+// NormalizeValue checks value against the field's type and returns it in canonical
+// stored form: trimmed strings, float64 numbers, YYYY-MM-DD dates, multi-select
+// options in definition order. Empty values (nil, "", an empty list, false for a
+// checkbox) normalize to nil. ok is false when value is not valid for the field;
+// msg then says why.
+func (f *CustomField) NormalizeValue(value any) (normalized any, ok bool, msg string) {
+	if value == nil {
+		return nil, true, ""
+	}
+	switch f.Type {
+	case FieldText, FieldURL, FieldDate, FieldSelect:
+		s, isString := value.(string)
+		if !isString {
+			return nil, false, f.Name + " must be a string"
+		}
+		s = strings.TrimSpace(s)
+		if s == "" {
+			return nil, true, ""
+		}
+		switch f.Type {
+		case FieldText:
+			if utf8.RuneCountInString(s) > MaxFieldTextLength {
+				return nil, false, f.Name + " is too long"
+			}
+		case FieldURL:
+			u, err := url.Parse(s)
+			if err != nil || len(s) > MaxFieldURLLength || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return nil, false, f.Name + " must be an http or https URL"
+			}
+		case FieldDate:
+			if _, err := time.Parse(time.DateOnly, s); err != nil {
+				return nil, false, f.Name + " must be YYYY-MM-DD"
+			}
+		case FieldSelect:
+			if !slices.Contains(f.Options, s) {
+				return nil, false, f.Name + " must be one of its options"
+			}
+		}
+		return s, true, ""
+
+	case FieldNumber:
+		switch n := value.(type) {
+		case float64:
+			return n, true, ""
+		case int:
+			return float64(n), true, ""
+		case string:
+			if strings.TrimSpace(n) == "" {
+				return nil, true, ""
+			}
+			if v, err := strconv.ParseFloat(strings.TrimSpace(n), 64); err == nil {
+				return v, true, ""
+			}
+		}
+		return nil, false, f.Name + " must be a number"
+
+	case FieldCheckbox:
+		b, isBool := value.(bool)
+		if !isBool {
+			return nil, false, f.Name + " must be true or false"
+		}
+		if !b {
+			return nil, true, ""
+		}
+		return true, true, ""
+
+	case FieldMultiSelect:
+		var picked []string
+		switch vs := value.(type) {
+		case []string:
+			picked = vs
+		case []any:
+			for _, v := range vs {
+				s, isString := v.(string)
+				if !isString {
+					return nil, false, f.Name + " must be a list of options"
+				}
+				picked = append(picked, s)
+			}
+		default:
+			return nil, false, f.Name + " must be a list of options"
+		}
+		var out []any
+		for _, opt := range f.Options {
+			if slices.Contains(picked, opt) {
+				out = append(out, opt)
+			}
+		}
+		if len(out) != len(dedupe(picked)) {
+			return nil, false, f.Name + " must only contain its options"
+		}
+		if len(out) == 0 {
+			return nil, true, ""
+		}
+		return out, true, ""
+	}
+	return nil, false, f.Name + " has an unknown type"
+}
+
+// Matches reports whether a card's stored value for the field satisfies filter value want.
+func (f *CustomField) Matches(have, want any) bool {
+	if want == nil {
+		return have == nil
+	}
+	if have == nil {
+		return false
+	}
+	switch f.Type {
+	case FieldText, FieldURL:
+		h, _ := have.(string)
+		w, _ := want.(string)
+		return strings.Contains(strings.ToLower(h), strings.ToLower(w))
+	case FieldMultiSelect:
+		hs, _ := have.([]any)
+		return slices.Contains(hs, want)
+	case FieldNumber:
+		w, ok, _ := f.NormalizeValue(want)
+		return ok && w == have
+	default:
+		return have == want
+	}
+}
+
+// dedupe returns ss without repeated values, keeping first occurrences.
+func dedupe(ss []string) []string {
+	var out []string
+	for _, s := range ss {
+		if !slices.Contains(out, s) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
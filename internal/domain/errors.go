@@ -11,4 +11,10 @@ var (
 	ErrNotFound   = errors.New("not found")
 	ErrValidation = errors.New("validation error")
 	ErrLastColumn = errors.New("cannot delete the last column in a board")
+	// ErrInsufficientHistory means a board has too little completed work to forecast from.
+	ErrInsufficientHistory = errors.New("not enough completed cards in history")
+	// ErrTransitionNotAllowed means the board's workflow forbids moving a card between two columns.
+	ErrTransitionNotAllowed = errors.New("transition not allowed")
+	// ErrScriptFailed means a user script failed to load, raised an error or hit a sandbox limit.
+	ErrScriptFailed = errors.New("script failed")
 )
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Estimate scale kinds.
+const (
+	EstimateScaleFibonacci = "fibonacci"
+	EstimateScaleTShirt    = "tshirt"
+	EstimateScaleHours     = "hours"
+)
+
+// MaxEstimate bounds free-form (hours) estimates.
+const MaxEstimate = 10000
+
+// EstimateScale is the set of estimates a board accepts.
+//
+// What: A named scale; Values lists the allowed labels and their numeric weights, or is
+// empty for a free-form numeric scale.
+// Why: Teams estimate differently — points, T-shirt sizes, hours — but rollups,
+// throughput and forecasts need numbers.
+// When: Chosen per board; cards always store the numeric value.
+type EstimateScale struct {
+	Kind   string          `json:"kind"`
+	Values []EstimateValue `json:"values"`
+}
+
+// EstimateValue is one allowed estimate on a scale.
+type EstimateValue struct {
+	Label string  `json:"label"`
+	Value float64 `json:"value"`
+}
+
+// EstimateScales are the built-in scales, keyed by kind.
+var EstimateScales = map[string]EstimateScale{
+	EstimateScaleFibonacci: {Kind: EstimateScaleFibonacci, Values: []EstimateValue{
+		{"0", 0}, {"1", 1}, {"2", 2}, {"3", 3}, {"5", 5}, {"8", 8}, {"13", 13}, {"21", 21}, {"34", 34}, {"55", 55}, {"89", 89},
+	}},
+	EstimateScaleTShirt: {Kind: EstimateScaleTShirt, Values: []EstimateValue{
+		{"XS", 1}, {"S", 2}, {"M", 3}, {"L", 5}, {"XL", 8}, {"XXL", 13},
+	}},
+	EstimateScaleHours: {Kind: EstimateScaleHours, Values: []EstimateValue{}},
+}
+
+// Parse converts user input — a label or a number — into an estimate on this scale.
+func (s EstimateScale) Parse(input string) (float64, bool) {
+	input = strings.TrimSpace(input)
+	n, err := strconv.ParseFloat(input, 64)
+	if len(s.Values) == 0 {
+		return n, err == nil && n >= 0 && n <= MaxEstimate
+	}
+	for _, v := range s.Values {
+		if strings.EqualFold(v.Label, input) || err == nil && v.Value == n {
+			return v.Value, true
+		}
+	}
+	return 0, false
+}
+
+// FormatEstimate renders an estimate in its canonical stored form.
+func FormatEstimate(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
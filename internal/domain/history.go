@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Card history event kinds.
+const (
+	CardEventCreated  = "created"
+	CardEventMoved    = "moved"
+	CardEventArchived = "archived"
+	CardEventDeleted  = "deleted"
+	// CardEventRestored marks a deleted or archived card brought back by undo.
+	CardEventRestored = "restored"
+)
+
+// CardEvent is one entry in a card's history.
+//
+// What: A record that a card entered a column (created, moved — possibly from another board —
+// or restored by undo) or left its board (archived, deleted). ToColumnTitle snapshots the column's title so
+// deleted columns can still be labelled.
+// Why: Moves are otherwise lost once they happen; history answers "where has this card been"
+// and is the raw data for flow metrics.
+// When: Appended on every card lifecycle change; never updated. Survives card deletion.
+type CardEvent struct {
+	ID            int64     `json:"id"`
+	CardID        string    `json:"card_id"`
+	BoardID       string    `json:"board_id"`
+	Kind          string    `json:"kind"`
+	FromBoardID   string    `json:"from_board_id,omitempty"`
+	FromColumnID  string    `json:"from_column_id,omitempty"`
+	ToColumnID    string    `json:"to_column_id,omitempty"`
+	ToColumnTitle string    `json:"to_column_title,omitempty"`
+	At            time.Time `json:"at"`
+}
+
+// HistoryRepository defines persistence for card history.
+type HistoryRepository interface {
+	Record(ctx context.Context, event *CardEvent) error
+	// ListByCard returns a card's events, oldest first.
+	ListByCard(ctx context.Context, cardID string) ([]CardEvent, error)
+	// ListByBoard returns events that touch boardID (including cards leaving it)
+	// up to and including until, oldest first.
+	ListByBoard(ctx context.Context, boardID string, until time.Time) ([]CardEvent, error)
+}
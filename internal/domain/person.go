@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Person field limits.
+const (
+	MaxPersonNameLength = 100
+	MaxInitialsLength   = 3
+	MaxEmailLength      = 254
+)
+
+// AssigneeNone is an assignee filter value matching cards with no assignees.
+const AssigneeNone = "none"
+
+// Person is someone cards can be assigned to.
+//
+// What: An entry in the app-wide people directory with display name, initials, avatar color and email.
+// Why: Boards are shared (exported, synced) by small teams who need to know who owns each card.
+// When: Managed from the people settings; deleting a person unassigns them from every card.
+type Person struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Initials  string    `json:"initials"`
+	Color     string    `json:"color"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PersonInput carries the user-editable fields of a Person. Empty Initials and Color
+// are derived from the name.
+type PersonInput struct {
+	Name     string `json:"name"`
+	Initials string `json:"initials"`
+	Color    string `json:"color"`
+	Email    string `json:"email"`
+}
+
+// PersonRepository defines persistence operations for the people directory.
+type PersonRepository interface {
+	GetAll(ctx context.Context) ([]Person, error)
+	GetByID(ctx context.Context, id string) (*Person, error)
+	Create(ctx context.Context, p *Person) error
+	Update(ctx context.Context, p *Person) error
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// Built-in priority keys. Every board starts with these levels; boards may rename,
+// recolor, remove or add levels.
+const (
+	PriorityNone     = "none"
+	PriorityLow      = "low"
+	PriorityMedium   = "medium"
+	PriorityHigh     = "high"
+	PriorityCritical = "critical"
+)
+
+// Priority scheme limits.
+const (
+	MaxPriorityLevels     = 20
+	MaxPriorityKeyLength  = 32
+	MaxPriorityNameLength = 50
+)
+
+// PriorityAtLeastSuffix turns a priority filter into a threshold: "high+" matches
+// high and every level weighted above it.
+const PriorityAtLeastSuffix = "+"
+
+// PriorityLevel is one level of a board's priority scheme.
+//
+// What: A stable Key stored on cards, with a display Name and Color. Weight orders
+// the levels: higher is more urgent.
+// Why: Teams triage differently — some need "critical", some want "none" — so the
+// set of priorities is per board rather than fixed.
+// When: Edited from the board settings. Cards, card templates and recurrences store
+// the Key; removing a level moves them to the remaining level of nearest weight.
+type PriorityLevel struct {
+	Key    string `json:"key"`
+	Name   string `json:"name"`
+	Color  string `json:"color"`
+	Weight int    `json:"weight"`
+}
+
+// DefaultPriorities returns the scheme new boards start with, lowest first.
+func DefaultPriorities() []PriorityLevel {
+	return []PriorityLevel{
+		{Key: PriorityNone, Name: "無", Color: "#9CA3AF", Weight: 0},
+		{Key: PriorityLow, Name: "低", Color: "#22C55E", Weight: 10},
+		{Key: PriorityMedium, Name: "中", Color: "#EAB308", Weight: 20},
+		{Key: PriorityHigh, Name: "高", Color: "#F97316", Weight: 30},
+		{Key: PriorityCritical, Name: "緊急", Color: "#EF4444", Weight: 40},
+	}
+}
+
+// FindPriority returns the level with key.
+func FindPriority(levels []PriorityLevel, key string) (PriorityLevel, bool) {
+	i := slices.IndexFunc(levels, func(l PriorityLevel) bool { return l.Key == key })
+	if i < 0 {
+		return PriorityLevel{}, false
+	}
+	return levels[i], true
+}
+
+// DefaultPriority is the key new cards get on a board: medium when the board has it,
+// otherwise the level nearest to medium's default weight.
+func DefaultPriority(levels []PriorityLevel) string {
+	return NearestPriority(levels, 20)
+}
+
+// NearestPriority returns the key of the level whose weight is closest to weight,
+// preferring the more urgent level on a tie. It returns "" when levels is empty.
+func NearestPriority(levels []PriorityLevel, weight int) string {
+	best := -1
+	for i, l := range levels {
+		d, bd := abs(l.Weight-weight), 0
+		if best >= 0 {
+			bd = abs(levels[best].Weight - weight)
+		}
+		if best < 0 || d < bd || d == bd && l.Weight > levels[best].Weight {
+			best = i
+		}
+	}
+	if best < 0 {
+		return ""
+	}
+	return levels[best].Key
+}
+
+// MapPriority translates key from one scheme to another: kept when to has it,
+// otherwise the level of to nearest in weight to key's weight in from.
+func MapPriority(key string, from, to []PriorityLevel) string {
+	if _, ok := FindPriority(to, key); ok {
+		return key
+	}
+	if l, ok := FindPriority(from, key); ok {
+		return NearestPriority(to, l.Weight)
+	}
+	return DefaultPriority(to)
+}
+
+// MatchesPriority reports whether key satisfies a priority filter: an exact key,
+// or a key with PriorityAtLeastSuffix for that level or above.
+func MatchesPriority(levels []PriorityLevel, key, filter string) bool {
+	threshold, atLeast := strings.CutSuffix(filter, PriorityAtLeastSuffix)
+	if !atLeast {
+		return key == filter
+	}
+	min, ok := FindPriority(levels, threshold)
+	l, found := FindPriority(levels, key)
+	return ok && found && l.Weight >= min.Weight
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,209 @@
+package domain
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Quick-add token kinds.
+const (
+	QuickAddPriority = "priority"
+	QuickAddLabel    = "label"
+	QuickAddAssignee = "assignee"
+	QuickAddDue      = "due"
+	QuickAddEstimate = "estimate"
+)
+
+// QuickAddToken is an inline token recognised in quick-add text.
+//
+// What: "!high" (priority), "#backend" (label), "@alice" (assignee), "~3" (estimate),
+// "due:friday" (due date), or a bare Chinese date phrase such as 明天 or 下週一.
+// Why: Typing "Fix login !high @alice due:friday" is faster than creating a card
+// and then editing each field.
+// When: Produced by ParseQuickAdd; CardService.QuickAdd resolves each token
+// against the board and reports the outcome as a QuickAddDiagnostic. Tokens that
+// do not resolve stay in the card title.
+type QuickAddToken struct {
+	Kind string
+	// Raw is the token as typed, e.g. "!high".
+	Raw string
+	// Value is the token without its marker, e.g. "high".
+	Value string
+	// Word is the token's index among the whitespace-separated words of the text.
+	Word int
+}
+
+// QuickAddDiagnostic reports how one quick-add token was resolved.
+type QuickAddDiagnostic struct {
+	Token   string `json:"token"`
+	Kind    string `json:"kind"`
+	Applied bool   `json:"applied"`
+	// Value is what the token resolved to: a priority key, option, person name,
+	// YYYY-MM-DD date or estimate.
+	Value string `json:"value,omitempty"`
+	// Message explains why a token was not applied.
+	Message string `json:"message,omitempty"`
+}
+
+// QuickAddResult is the card created from quick-add text and how each token was resolved.
+type QuickAddResult struct {
+	Card        *Card                `json:"card"`
+	Diagnostics []QuickAddDiagnostic `json:"diagnostics"`
+}
+
+// ParseQuickAdd finds the inline tokens in quick-add text. Words are separated by
+// whitespace; every word that is not a token belongs to the title.
+func ParseQuickAdd(text string) []QuickAddToken {
+	var tokens []QuickAddToken
+	for i, w := range strings.Fields(text) {
+		if tok, ok := quickAddToken(w); ok {
+			tok.Word = i
+			tokens = append(tokens, tok)
+		}
+	}
+	return tokens
+}
+
+// QuickAddTitle returns text without the words of the removed tokens, joined by single spaces.
+func QuickAddTitle(text string, removed []QuickAddToken) string {
+	skip := make(map[int]bool, len(removed))
+	for _, tok := range removed {
+		skip[tok.Word] = true
+	}
+	var words []string
+	for i, w := range strings.Fields(text) {
+		if !skip[i] {
+			words = append(words, w)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// quickAddMarkers maps a token's leading character to its kind.
+var quickAddMarkers = map[byte]string{'!': QuickAddPriority, '#': QuickAddLabel, '@': QuickAddAssignee, '~': QuickAddEstimate}
+
+func quickAddToken(w string) (QuickAddToken, bool) {
+	if kind, ok := quickAddMarkers[w[0]]; ok && len(w) > 1 {
+		return QuickAddToken{Kind: kind, Raw: w, Value: w[1:]}, true
+	}
+	if len(w) > 4 && strings.EqualFold(w[:4], "due:") {
+		return QuickAddToken{Kind: QuickAddDue, Raw: w, Value: w[4:]}, true
+	}
+	// Chinese has no spaces between words, so a standalone date phrase is unambiguous.
+	if hasHan(w) {
+		if _, ok := ParseRelativeDate(w, time.Now()); ok {
+			return QuickAddToken{Kind: QuickAddDue, Raw: w, Value: w}, true
+		}
+	}
+	return QuickAddToken{}, false
+}
+
+func hasHan(s string) bool {
+	for _, r := range s {
+		if unicode.Is(unicode.Han, r) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	weekdayNames = map[string]time.Weekday{
+		"sun": time.Sunday, "sunday": time.Sunday,
+		"mon": time.Monday, "monday": time.Monday,
+		"tue": time.Tuesday, "tues": time.Tuesday, "tuesday": time.Tuesday,
+		"wed": time.Wednesday, "wednesday": time.Wednesday,
+		"thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday, "thursday": time.Thursday,
+		"fri": time.Friday, "friday": time.Friday,
+		"sat": time.Saturday, "saturday": time.Saturday,
+	}
+	zhDayOffsets = map[string]int{
+		"今天": 0, "今日": 0, "明天": 1, "明日": 1, "後天": 2, "后天": 2, "大後天": 3, "大后天": 3,
+	}
+	zhWeekdays = map[string]time.Weekday{
+		"一": time.Monday, "二": time.Tuesday, "三": time.Wednesday, "四": time.Thursday,
+		"五": time.Friday, "六": time.Saturday, "日": time.Sunday, "天": time.Sunday,
+	}
+
+	relativeOffsetPattern = regexp.MustCompile(`^\+(\d{1,3})([dw])$`)
+	monthDayPattern       = regexp.MustCompile(`^(\d{1,2})/(\d{1,2})$`)
+	zhWeekdayPattern      = regexp.MustCompile(`^(本|這|这|下下|下)?(週|周|星期|禮拜|礼拜)([一二三四五六日天])$`)
+	zhDaysLaterPattern    = regexp.MustCompile(`^(\d{1,3})天[後后]$`)
+)
+
+// ParseRelativeDate resolves a date phrase relative to now, in now's location,
+// and returns midnight of that day. It accepts YYYY-MM-DD; M/D (the next such day,
+// so 2/29 is the next leap day); today, tomorrow (tmr) and +3d or +2w; weekday
+// names, meaning the next such day from today on; and the Chinese phrases 今天,
+// 明天, 後天, 大後天, 3天後, 週五 (the next Friday from today on), 本週五 (Friday of
+// this week), 下週一 (Monday of next week) and 下下週一. Weeks start on Monday.
+func ParseRelativeDate(s string, now time.Time) (time.Time, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	days := func(n int) time.Time { return today.AddDate(0, 0, n) }
+	// upcoming returns the next wd from today on.
+	upcoming := func(wd time.Weekday) time.Time {
+		return days((int(wd) - int(today.Weekday()) + 7) % 7)
+	}
+	// inWeek returns wd in the Monday-based week that is weeks weeks after this one.
+	inWeek := func(wd time.Weekday, weeks int) time.Time {
+		monday := days(-((int(today.Weekday()) + 6) % 7))
+		return monday.AddDate(0, 0, 7*weeks+(int(wd)+6)%7)
+	}
+
+	switch s {
+	case "today", "tod":
+		return today, true
+	case "tomorrow", "tmr", "tmrw":
+		return days(1), true
+	}
+	if wd, ok := weekdayNames[s]; ok {
+		return upcoming(wd), true
+	}
+	if n, ok := zhDayOffsets[s]; ok {
+		return days(n), true
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, s, now.Location()); err == nil {
+		return t, true
+	}
+	if m := relativeOffsetPattern.FindStringSubmatch(s); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		if m[2] == "w" {
+			n *= 7
+		}
+		return days(n), true
+	}
+	if m := zhDaysLaterPattern.FindStringSubmatch(s); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		return days(n), true
+	}
+	if m := monthDayPattern.FindStringSubmatch(s); m != nil {
+		month, _ := strconv.Atoi(m[1])
+		day, _ := strconv.Atoi(m[2])
+		// 2/29 may be up to eight years away; any other day is at most a year away.
+		for year := today.Year(); year <= today.Year()+8; year++ {
+			t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, now.Location())
+			if t.Month() == time.Month(month) && t.Day() == day && !t.Before(today) {
+				return t, true
+			}
+		}
+		return time.Time{}, false
+	}
+	if m := zhWeekdayPattern.FindStringSubmatch(s); m != nil {
+		wd := zhWeekdays[m[3]]
+		switch m[1] {
+		case "":
+			return upcoming(wd), true
+		case "下":
+			return inWeek(wd, 1), true
+		case "下下":
+			return inWeek(wd, 2), true
+		default:
+			return inWeek(wd, 0), true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseQuickAdd(t *testing.T) {
+	tests := []struct {
+		text   string
+		tokens []QuickAddToken
+		title  string
+	}{
+		{
+			text: "Fix login !high #backend @alice due:friday ~3",
+			tokens: []QuickAddToken{
+				{Kind: QuickAddPriority, Raw: "!high", Value: "high", Word: 2},
+				{Kind: QuickAddLabel, Raw: "#backend", Value: "backend", Word: 3},
+				{Kind: QuickAddAssignee, Raw: "@alice", Value: "alice", Word: 4},
+				{Kind: QuickAddDue, Raw: "due:friday", Value: "friday", Word: 5},
+				{Kind: QuickAddEstimate, Raw: "~3", Value: "3", Word: 6},
+			},
+			title: "Fix login",
+		},
+		{
+			text: "明天 交報告 @小明",
+			tokens: []QuickAddToken{
+				{Kind: QuickAddDue, Raw: "明天", Value: "明天", Word: 0},
+				{Kind: QuickAddAssignee, Raw: "@小明", Value: "小明", Word: 2},
+			},
+			title: "交報告",
+		},
+		{
+			text:   "Plan 下週一 review",
+			tokens: []QuickAddToken{{Kind: QuickAddDue, Raw: "下週一", Value: "下週一", Word: 1}},
+			title:  "Plan review",
+		},
+		{
+			text:   "寫周報 3天後",
+			tokens: []QuickAddToken{{Kind: QuickAddDue, Raw: "3天後", Value: "3天後", Word: 1}},
+			title:  "寫周報",
+		},
+		{
+			text:   "DUE:tmr email",
+			tokens: []QuickAddToken{{Kind: QuickAddDue, Raw: "DUE:tmr", Value: "tmr", Word: 0}},
+			title:  "email",
+		},
+		{
+			text:   "  spaced   !low  out ",
+			tokens: []QuickAddToken{{Kind: QuickAddPriority, Raw: "!low", Value: "low", Word: 1}},
+			title:  "spaced out",
+		},
+		{text: "markers alone ! # @ ~ due:", title: "markers alone ! # @ ~ due:"},
+		{text: "a!b c#d friday", title: "a!b c#d friday"},
+		{text: "明天早上 開會", title: "明天早上 開會"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			tokens := ParseQuickAdd(tt.text)
+			if !reflect.DeepEqual(tokens, tt.tokens) {
+				t.Errorf("tokens = %+v, want %+v", tokens, tt.tokens)
+			}
+			if title := QuickAddTitle(tt.text, tokens); title != tt.title {
+				t.Errorf("title = %q, want %q", title, tt.title)
+			}
+		})
+	}
+}
+
+func TestParseRelativeDate(t *testing.T) {
+	// Wednesday afternoon in a zone ahead of UTC, so a UTC-based calculation would be a day off.
+	loc := time.FixedZone("UTC+8", 8*60*60)
+	now := time.Date(2026, 10, 14, 15, 0, 0, 0, loc)
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"today", "2026-10-14"},
+		{"TOD", "2026-10-14"},
+		{"tomorrow", "2026-10-15"},
+		{"tmr", "2026-10-15"},
+		{"fri", "2026-10-16"},
+		{"Wednesday", "2026-10-14"},
+		{"mon", "2026-10-19"},
+		{"2026-12-01", "2026-12-01"},
+		{"+3d", "2026-10-17"},
+		{"+2w", "2026-10-28"},
+		{"12/25", "2026-12-25"},
+		{"10/14", "2026-10-14"},
+		{"1/5", "2027-01-05"},
+		{"2/29", "2028-02-29"},
+		{"今天", "2026-10-14"},
+		{"明天", "2026-10-15"},
+		{"明日", "2026-10-15"},
+		{"後天", "2026-10-16"},
+		{"后天", "2026-10-16"},
+		{"大後天", "2026-10-17"},
+		{"3天後", "2026-10-17"},
+		{"10天后", "2026-10-24"},
+		{"週五", "2026-10-16"},
+		{"周三", "2026-10-14"},
+		{"星期日", "2026-10-18"},
+		{"禮拜一", "2026-10-19"},
+		{"本週一", "2026-10-12"},
+		{"這週五", "2026-10-16"},
+		{"这周日", "2026-10-18"},
+		{"下週一", "2026-10-19"},
+		{"下周日", "2026-10-25"},
+		{"下星期六", "2026-10-24"},
+		{"下下週三", "2026-10-28"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, ok := ParseRelativeDate(tt.in, now)
+			if !ok {
+				t.Fatalf("%q was not recognised", tt.in)
+			}
+			want, _ := time.ParseInLocation(time.DateOnly, tt.want, loc)
+			if !got.Equal(want) || got.Location() != loc {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestParseRelativeDateLeapDay(t *testing.T) {
+	tests := []struct {
+		now  string
+		want string
+	}{
+		{"2028-02-29", "2028-02-29"},
+		{"2028-03-01", "2032-02-29"},
+		{"2096-03-01", "2104-02-29"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.now, func(t *testing.T) {
+			now, _ := time.Parse(time.DateOnly, tt.now)
+			got, ok := ParseRelativeDate("2/29", now)
+			if !ok {
+				t.Fatal("2/29 was not recognised")
+			}
+			if got.Format(time.DateOnly) != tt.want {
+				t.Errorf("got %v, want %s", got.Format(time.DateOnly), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRelativeDateRejects(t *testing.T) {
+	now := time.Date(2026, 10, 14, 15, 0, 0, 0, time.UTC)
+	for _, in := range []string{
+		"",
+		"yesterday",
+		"+1m",
+		"+1000d",
+		"2/30",
+		"13/01",
+		"2026-13-01",
+		"週八",
+		"上週一",
+		"明天早上",
+		"天後",
+	} {
+		t.Run(in, func(t *testing.T) {
+			if got, ok := ParseRelativeDate(in, now); ok {
+				t.Errorf("got %v, want no date", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Recurrence trigger modes.
+const (
+	// RecurrenceOnSchedule creates a new card each time the rule fires.
+	RecurrenceOnSchedule = "schedule"
+	// RecurrenceOnCompletion creates the next card, due at the rule's next occurrence,
+	// once the previous one has been completed (moved to a done column), archived or deleted.
+	RecurrenceOnCompletion = "on_completion"
+)
+
+// Catch-up policies for occurrences missed while the app was closed.
+const (
+	// CatchUpLatest creates a single card for the most recent missed occurrence.
+	CatchUpLatest = "latest"
+	// CatchUpAll creates one card per missed occurrence, up to MaxCatchUpCards.
+	CatchUpAll = "all"
+)
+
+// MaxCatchUpCards caps how many missed occurrences are created in one go.
+const MaxCatchUpCards = 50
+
+// Recurrence makes a card reappear on a schedule.
+//
+// What: An RRULE plus the content (copied from a card, or read live from a card template)
+// and target column of the cards it creates.
+// Why: Weekly chores and other routine work shouldn't need to be re-created by hand.
+// When: Created from a card or a card template; advanced by the recurrence scheduler;
+// removed explicitly or when its target column, board or template is deleted.
+//
+// When AllDay is set, StartAt is a calendar day and the cards created get all-day due dates.
+// NextRunAt is nil once the rule has ended; in on_completion mode it is the due date of
+// the next card to create.
+type Recurrence struct {
+	ID          string     `json:"id"`
+	BoardID     string     `json:"board_id"`
+	ColumnID    string     `json:"column_id"`
+	TemplateID  string     `json:"template_id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Priority    string     `json:"priority"`
+	Rule        string     `json:"rule"`
+	StartAt     time.Time  `json:"start_at"`
+	AllDay      bool       `json:"all_day"`
+	Mode        string     `json:"mode"`
+	CatchUp     string     `json:"catch_up"`
+	NextRunAt   *time.Time `json:"next_run_at"`
+	LastCardID  string     `json:"last_card_id"`
+	Occurrences int        `json:"occurrences"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// RecurrenceInput describes a new recurrence. Exactly one of CardID and TemplateID is set;
+// ColumnID is required for templates and defaults to the card's column otherwise.
+// StartAt accepts the same formats as card dates and defaults to now.
+type RecurrenceInput struct {
+	CardID     string `json:"card_id"`
+	TemplateID string `json:"template_id"`
+	ColumnID   string `json:"column_id"`
+	Rule       string `json:"rule"`
+	StartAt    string `json:"start_at"`
+	Mode       string `json:"mode"`
+	CatchUp    string `json:"catch_up"`
+}
+
+// RecurrenceRepository defines persistence operations for recurrences.
+type RecurrenceRepository interface {
+	GetByBoardID(ctx context.Context, boardID string) ([]Recurrence, error)
+	GetByID(ctx context.Context, id string) (*Recurrence, error)
+	// GetActive returns recurrences whose rule has not ended (NextRunAt is set).
+	GetActive(ctx context.Context) ([]Recurrence, error)
+	Create(ctx context.Context, rec *Recurrence) error
+	// UpdateProgress persists NextRunAt, LastCardID and Occurrences.
+	UpdateProgress(ctx context.Context, rec *Recurrence) error
+	Delete(ctx context.Context, id string) error
+}
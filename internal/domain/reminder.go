@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Reminder kinds.
+const (
+	ReminderDueSoon = "due_soon"
+	ReminderOverdue = "overdue"
+	ReminderSnoozed = "snoozed"
+)
+
+// ReminderSettings controls when due-date reminders fire.
+//
+// What: User preferences for the background reminder scheduler.
+// Why: Different users want different warning windows (a day ahead, an hour ahead, or none).
+// When: Read on every scan; edited from the settings UI.
+type ReminderSettings struct {
+	Enabled bool `json:"enabled"`
+	// LeadTimesMinutes lists how long before the due date a reminder fires, e.g. [1440, 60].
+	LeadTimesMinutes []int `json:"lead_times_minutes"`
+}
+
+// DefaultReminderSettings returns the settings used before the user changes anything.
+func DefaultReminderSettings() ReminderSettings {
+	return ReminderSettings{Enabled: true, LeadTimesMinutes: []int{1440, 60}}
+}
+
+// Reminder is a notification about a card that is due soon or overdue.
+//
+// What: A single fired reminder with enough context to render a notification.
+// Why: The scheduler produces reminders; the adapter turns them into desktop notifications and frontend events.
+// When: Created by ReminderService during a scan; never persisted (only its dedup key is).
+type Reminder struct {
+	CardID      string    `json:"card_id"`
+	CardTitle   string    `json:"card_title"`
+	BoardID     string    `json:"board_id"`
+	BoardTitle  string    `json:"board_title"`
+	DueAt       time.Time `json:"due_at"`
+	AllDay      bool      `json:"all_day"`
+	Kind        string    `json:"kind"`
+	LeadMinutes int       `json:"lead_minutes"`
+}
+
+// ReminderCandidate is a card with a due date plus the context needed to notify about it.
+type ReminderCandidate struct {
+	CardID     string
+	CardTitle  string
+	BoardID    string
+	BoardTitle string
+	DueAt      time.Time
+	AllDay     bool
+}
+
+// ReminderRepository defines persistence for reminder settings, dedup state and snoozes.
+type ReminderRepository interface {
+	GetSettings(ctx context.Context) (ReminderSettings, error)
+	SaveSettings(ctx context.Context, settings ReminderSettings) error
+	// ListCandidates returns open cards with a due date: not archived and not completed.
+	ListCandidates(ctx context.Context) ([]ReminderCandidate, error)
+	// WasSent reports whether the reminder identified by key was already sent for this due date.
+	WasSent(ctx context.Context, cardID, key string, due time.Time) (bool, error)
+	MarkSent(ctx context.Context, cardID, key string, due, sentAt time.Time) error
+	Snooze(ctx context.Context, cardID string, until time.Time) error
+	// Snoozes returns the snooze deadline per card ID. A card's snooze is dropped when
+	// it is completed.
+	Snoozes(ctx context.Context) (map[string]time.Time, error)
+	ClearSnooze(ctx context.Context, cardID string) error
+}
+
+// Notifier delivers a notification to the user's desktop.
+type Notifier interface {
+	Notify(title, message string) error
+}
@@ -0,0 +1,242 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RRule frequencies supported from RFC 5545.
+const (
+	FreqDaily   = "DAILY"
+	FreqWeekly  = "WEEKLY"
+	FreqMonthly = "MONTHLY"
+	FreqYearly  = "YEARLY"
+)
+
+// maxRRuleIterations bounds occurrence generation so a pathological rule cannot spin forever.
+const maxRRuleIterations = 100000
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+// RRule is a parsed subset of an RFC 5545 recurrence rule.
+//
+// What: FREQ (DAILY/WEEKLY/MONTHLY/YEARLY) with INTERVAL, BYDAY (weekly only, plain weekdays),
+// BYMONTHDAY (monthly only, 1–31), COUNT and UNTIL.
+// Why: Recurring cards need a compact, standard, storable schedule description.
+// When: Parsed from Recurrence.Rule whenever the next occurrence is computed.
+type RRule struct {
+	Freq       string
+	Interval   int
+	ByDay      []time.Weekday
+	ByMonthDay int
+	Count      int
+	Until      *time.Time
+}
+
+// ParseRRule parses a rule such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH".
+// An optional "RRULE:" prefix is accepted.
+func ParseRRule(s string) (*RRule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	r := &RRule{Interval: 1}
+	if s == "" {
+		return nil, fmt.Errorf("%w: recurrence rule is empty", ErrValidation)
+	}
+
+	for _, part := range strings.Split(s, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: malformed rule part %q", ErrValidation, part)
+		}
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			r.Freq = strings.ToUpper(val)
+		case "INTERVAL":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("%w: INTERVAL must be a positive integer", ErrValidation)
+			}
+			r.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("%w: COUNT must be a positive integer", ErrValidation)
+			}
+			r.Count = n
+		case "UNTIL":
+			t, err := parseRRuleTime(val)
+			if err != nil {
+				return nil, err
+			}
+			r.Until = &t
+		case "BYDAY":
+			for _, d := range strings.Split(strings.ToUpper(val), ",") {
+				wd, ok := rruleWeekdays[d]
+				if !ok {
+					return nil, fmt.Errorf("%w: unsupported BYDAY value %q", ErrValidation, d)
+				}
+				r.ByDay = append(r.ByDay, wd)
+			}
+			sort.Slice(r.ByDay, func(i, j int) bool { return isoWeekday(r.ByDay[i]) < isoWeekday(r.ByDay[j]) })
+		case "BYMONTHDAY":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 || n > 31 {
+				return nil, fmt.Errorf("%w: BYMONTHDAY must be between 1 and 31", ErrValidation)
+			}
+			r.ByMonthDay = n
+		default:
+			return nil, fmt.Errorf("%w: unsupported rule part %q", ErrValidation, key)
+		}
+	}
+
+	switch r.Freq {
+	case FreqDaily, FreqWeekly, FreqMonthly, FreqYearly:
+	case "":
+		return nil, fmt.Errorf("%w: FREQ is required", ErrValidation)
+	default:
+		return nil, fmt.Errorf("%w: unsupported FREQ %q", ErrValidation, r.Freq)
+	}
+	if len(r.ByDay) > 0 && r.Freq != FreqWeekly {
+		return nil, fmt.Errorf("%w: BYDAY is only supported with FREQ=WEEKLY", ErrValidation)
+	}
+	if r.ByMonthDay != 0 && r.Freq != FreqMonthly {
+		return nil, fmt.Errorf("%w: BYMONTHDAY is only supported with FREQ=MONTHLY", ErrValidation)
+	}
+	if r.Count > 0 && r.Until != nil {
+		return nil, fmt.Errorf("%w: COUNT and UNTIL cannot both be set", ErrValidation)
+	}
+	return r, nil
+}
+
+func parseRRuleTime(s string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", s); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102", s, time.Local); err == nil {
+		// A date-only UNTIL includes the whole day.
+		return t.AddDate(0, 0, 1).Add(-time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("%w: UNTIL must be YYYYMMDD or YYYYMMDDTHHMMSSZ", ErrValidation)
+}
+
+// isoWeekday numbers weekdays Monday=0 … Sunday=6, the RFC 5545 default week start.
+func isoWeekday(d time.Weekday) int {
+	return (int(d) + 6) % 7
+}
+
+// Occurrences returns, in order, the occurrences of r anchored at dtstart that are
+// strictly after `after`, stopping at limit results. COUNT is counted from dtstart,
+// so occurrences at or before `after` still use up the rule's count.
+func (r *RRule) Occurrences(dtstart, after time.Time, limit int) []time.Time {
+	var out []time.Time
+	n := 0
+	r.each(dtstart, func(t time.Time) bool {
+		n++
+		if r.Count > 0 && n > r.Count {
+			return false
+		}
+		if r.Until != nil && t.After(*r.Until) {
+			return false
+		}
+		if t.After(after) {
+			out = append(out, t)
+		}
+		return len(out) < limit
+	})
+	return out
+}
+
+// Between returns, in order, the last (at most limit) occurrences of r anchored at
+// dtstart that are strictly after `after` and not after until.
+func (r *RRule) Between(dtstart, after, until time.Time, limit int) []time.Time {
+	if limit <= 0 {
+		return nil
+	}
+	var out []time.Time
+	n := 0
+	r.each(dtstart, func(t time.Time) bool {
+		n++
+		if (r.Count > 0 && n > r.Count) || (r.Until != nil && t.After(*r.Until)) || t.After(until) {
+			return false
+		}
+		if t.After(after) {
+			if len(out) == limit {
+				out = append(out[:0], out[1:]...)
+			}
+			out = append(out, t)
+		}
+		return true
+	})
+	return out
+}
+
+// Next returns the first occurrence strictly after `after`, or false when the rule has ended.
+func (r *RRule) Next(dtstart, after time.Time) (time.Time, bool) {
+	occ := r.Occurrences(dtstart, after, 1)
+	if len(occ) == 0 {
+		return time.Time{}, false
+	}
+	return occ[0], true
+}
+
+// each calls yield with every candidate occurrence from dtstart onwards, in order,
+// until yield returns false. Invalid dates (e.g. the 31st in a 30-day month) are skipped.
+func (r *RRule) each(dtstart time.Time, yield func(time.Time) bool) {
+	y, mo, d := dtstart.Date()
+	hh, mm, ss := dtstart.Clock()
+	loc := dtstart.Location()
+
+	for i := 0; i < maxRRuleIterations; i++ {
+		switch r.Freq {
+		case FreqDaily:
+			if !yield(time.Date(y, mo, d+i*r.Interval, hh, mm, ss, 0, loc)) {
+				return
+			}
+		case FreqWeekly:
+			if len(r.ByDay) == 0 {
+				if !yield(time.Date(y, mo, d+7*i*r.Interval, hh, mm, ss, 0, loc)) {
+					return
+				}
+				continue
+			}
+			weekStart := d - isoWeekday(dtstart.Weekday()) + 7*i*r.Interval
+			for _, wd := range r.ByDay {
+				t := time.Date(y, mo, weekStart+isoWeekday(wd), hh, mm, ss, 0, loc)
+				if t.Before(dtstart) {
+					continue
+				}
+				if !yield(t) {
+					return
+				}
+			}
+		case FreqMonthly:
+			day := d
+			if r.ByMonthDay != 0 {
+				day = r.ByMonthDay
+			}
+			first := time.Date(y, mo+time.Month(i*r.Interval), 1, hh, mm, ss, 0, loc)
+			t := time.Date(first.Year(), first.Month(), day, hh, mm, ss, 0, loc)
+			if t.Month() != first.Month() || t.Before(dtstart) {
+				continue
+			}
+			if !yield(t) {
+				return
+			}
+		case FreqYearly:
+			t := time.Date(y+i*r.Interval, mo, d, hh, mm, ss, 0, loc)
+			if t.Month() != mo {
+				continue // Feb 29 in a non-leap year
+			}
+			if !yield(t) {
+				return
+			}
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// at returns 09:00 UTC on the given day, the anchor time of the rule tests.
+func at(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 9, 0, 0, 0, time.UTC)
+}
+
+// dates formats times as YYYY-MM-DD for readable comparisons.
+func dates(ts []time.Time) []string {
+	out := []string{}
+	for _, t := range ts {
+		out = append(out, t.Format(time.DateOnly))
+	}
+	return out
+}
+
+func TestParseRRule(t *testing.T) {
+	until := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	untilDay := time.Date(2027, 1, 1, 23, 59, 59, 0, time.Local)
+	tests := []struct {
+		rule string
+		want RRule
+	}{
+		{"FREQ=DAILY", RRule{Freq: FreqDaily, Interval: 1}},
+		{"RRULE:freq=daily", RRule{Freq: FreqDaily, Interval: 1}},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=TH,MO", RRule{Freq: FreqWeekly, Interval: 2, ByDay: []time.Weekday{time.Monday, time.Thursday}}},
+		{"FREQ=WEEKLY;BYDAY=SU,MO", RRule{Freq: FreqWeekly, Interval: 1, ByDay: []time.Weekday{time.Monday, time.Sunday}}},
+		{"FREQ=MONTHLY;BYMONTHDAY=31;COUNT=3", RRule{Freq: FreqMonthly, Interval: 1, ByMonthDay: 31, Count: 3}},
+		{"FREQ=YEARLY;UNTIL=20270101T000000Z", RRule{Freq: FreqYearly, Interval: 1, Until: &until}},
+		{"FREQ=YEARLY;UNTIL=20270101", RRule{Freq: FreqYearly, Interval: 1, Until: &untilDay}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			got, err := ParseRRule(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("got %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRRuleErrors(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"RRULE:",
+		"FREQ",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=-1",
+		"FREQ=DAILY;BYSETPOS=1",
+		"FREQ=DAILY;BYDAY=MO",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=DAILY;UNTIL=2027-01-01",
+		"FREQ=DAILY;COUNT=2;UNTIL=20270101",
+	} {
+		t.Run(rule, func(t *testing.T) {
+			if _, err := ParseRRule(rule); !errors.Is(err, ErrValidation) {
+				t.Errorf("err = %v, want a validation error", err)
+			}
+		})
+	}
+}
+
+func TestRRuleOccurrences(t *testing.T) {
+	// 2026-01-31 is a Saturday.
+	start := at(2026, 1, 31)
+	tests := []struct {
+		name    string
+		rule    string
+		dtstart time.Time
+		after   time.Time
+		limit   int
+		want    []string
+	}{
+		{"daily includes dtstart", "FREQ=DAILY;INTERVAL=2", start, start.Add(-time.Second), 3,
+			[]string{"2026-01-31", "2026-02-02", "2026-02-04"}},
+		{"strictly after", "FREQ=DAILY;INTERVAL=2", start, start, 3,
+			[]string{"2026-02-02", "2026-02-04", "2026-02-06"}},
+		{"weekly by day skips days before dtstart", "FREQ=WEEKLY;BYDAY=MO,WE", start, start, 3,
+			[]string{"2026-02-02", "2026-02-04", "2026-02-09"}},
+		{"every other week", "FREQ=WEEKLY;INTERVAL=2", start, start.Add(-time.Second), 3,
+			[]string{"2026-01-31", "2026-02-14", "2026-02-28"}},
+		{"monthly skips short months", "FREQ=MONTHLY", start, start.Add(-time.Second), 3,
+			[]string{"2026-01-31", "2026-03-31", "2026-05-31"}},
+		{"month day before dtstart", "FREQ=MONTHLY;BYMONTHDAY=15", start, start, 3,
+			[]string{"2026-02-15", "2026-03-15", "2026-04-15"}},
+		{"leap day", "FREQ=YEARLY", at(2028, 2, 29), at(2028, 1, 1), 3,
+			[]string{"2028-02-29", "2032-02-29", "2036-02-29"}},
+		{"count includes skipped occurrences", "FREQ=DAILY;COUNT=3", start, at(2026, 2, 1), 10,
+			[]string{"2026-02-02"}},
+		{"until", "FREQ=DAILY;UNTIL=20260203T000000Z", start, start.Add(-time.Second), 10,
+			[]string{"2026-01-31", "2026-02-01", "2026-02-02"}},
+		{"ended", "FREQ=DAILY;COUNT=2", start, at(2026, 3, 1), 10, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRRule(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := dates(r.Occurrences(tt.dtstart, tt.after, tt.limit)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRRuleBetween(t *testing.T) {
+	start := at(2026, 1, 31)
+	tests := []struct {
+		name  string
+		rule  string
+		after time.Time
+		until time.Time
+		limit int
+		want  []string
+	}{
+		{"keeps the latest", "FREQ=DAILY", start, at(2026, 2, 10), 3,
+			[]string{"2026-02-08", "2026-02-09", "2026-02-10"}},
+		{"latest only", "FREQ=DAILY", start, at(2026, 2, 10), 1, []string{"2026-02-10"}},
+		{"until is inclusive", "FREQ=DAILY", start, at(2026, 2, 2), 10, []string{"2026-02-01", "2026-02-02"}},
+		{"after is exclusive", "FREQ=DAILY", start.Add(-time.Second), at(2026, 2, 1), 10,
+			[]string{"2026-01-31", "2026-02-01"}},
+		{"nothing due", "FREQ=DAILY", start, start.Add(time.Hour), 10, []string{}},
+		{"zero limit", "FREQ=DAILY", start, at(2026, 2, 10), 0, []string{}},
+		{"count", "FREQ=DAILY;COUNT=5", start, at(2026, 2, 10), 10,
+			[]string{"2026-02-01", "2026-02-02", "2026-02-03", "2026-02-04"}},
+		{"rule until", "FREQ=DAILY;UNTIL=20260203T000000Z", start, at(2026, 2, 10), 10,
+			[]string{"2026-02-01", "2026-02-02"}},
+		{"monthly", "FREQ=MONTHLY", start, at(2026, 6, 1), 5, []string{"2026-03-31", "2026-05-31"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRRule(tt.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := dates(r.Between(start, tt.after, tt.until, tt.limit)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
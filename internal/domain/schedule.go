@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Accepted input layouts for card dates.
+//
+// What: A date is either a calendar day ("2006-01-02", all-day) or an instant
+// (RFC3339 with offset, or "2006-01-02T15:04" interpreted in the local timezone).
+// Why: Free-form strings silently shifted or failed to parse; accepting only these
+// layouts keeps all-day dates timezone-free and timed dates unambiguous.
+// When: Parsed by CardService before start_date or due_at is stored.
+const (
+	dateLayout      = time.DateOnly
+	localTimeLayout = "2006-01-02T15:04"
+)
+
+// ParseCardDate parses a user-supplied card date and reports whether it is all-day.
+// Timed values are normalised to UTC.
+func ParseCardDate(s string) (t time.Time, allDay bool, err error) {
+	if t, err := time.Parse(dateLayout, s); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.UTC(), false, nil
+	}
+	if t, err := time.ParseInLocation(localTimeLayout, s, time.Local); err == nil {
+		return t.UTC(), false, nil
+	}
+	return time.Time{}, false, fmt.Errorf("%w: invalid date %q (want YYYY-MM-DD or RFC3339)", ErrValidation, s)
+}
+
+// FormatCardDate renders a card date in its canonical stored form.
+func FormatCardDate(t time.Time, allDay bool) string {
+	if allDay {
+		return t.Format(dateLayout)
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// DueDeadline returns the instant a due date expires: the instant itself for
+// timed dates, or the end of the calendar day in loc for all-day dates.
+func DueDeadline(t time.Time, allDay bool, loc *time.Location) time.Time {
+	if !allDay {
+		return t
+	}
+	y, m, d := t.Date()
+	return time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseCardDate(t *testing.T) {
+	local := time.Date(2026, 3, 5, 10, 30, 0, 0, time.Local).UTC().Format(time.RFC3339)
+	tests := []struct {
+		in     string
+		want   string
+		allDay bool
+	}{
+		{"2026-03-05", "2026-03-05", true},
+		{"2024-02-29", "2024-02-29", true},
+		{"2026-03-05T10:30:00Z", "2026-03-05T10:30:00Z", false},
+		{"2026-03-05T10:30:00+08:00", "2026-03-05T02:30:00Z", false},
+		{"2026-03-05T23:30:00-05:00", "2026-03-06T04:30:00Z", false},
+		{"2026-03-05T10:30", local, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, allDay, err := ParseCardDate(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if allDay != tt.allDay {
+				t.Errorf("allDay = %v, want %v", allDay, tt.allDay)
+			}
+			if s := FormatCardDate(got, allDay); s != tt.want {
+				t.Errorf("got %s, want %s", s, tt.want)
+			}
+			if !allDay && got.Location() != time.UTC {
+				t.Errorf("timed date in %v, want UTC", got.Location())
+			}
+		})
+	}
+}
+
+func TestParseCardDateErrors(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"tomorrow",
+		"2026/03/05",
+		"2026-02-30",
+		"2026-3-5",
+		"2026-03-05 10:30",
+		"2026-03-05T25:00",
+	} {
+		t.Run(in, func(t *testing.T) {
+			if _, _, err := ParseCardDate(in); !errors.Is(err, ErrValidation) {
+				t.Errorf("err = %v, want a validation error", err)
+			}
+		})
+	}
+}
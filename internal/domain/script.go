@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Script naming conventions.
+const (
+	// ScriptExt is the file extension of scripts in the scripts folder.
+	ScriptExt = ".star"
+	// ScriptHookPrefix starts the name of a function called on activity: on_ plus a
+	// card or board activity kind, e.g. on_card_moved or on_board_created.
+	ScriptHookPrefix = "on_"
+	// ScriptCommandPrefix starts the name of a function offered in the command palette,
+	// e.g. command_archive_done.
+	ScriptCommandPrefix = "command_"
+)
+
+// Script sandbox limits, applied to every script run.
+const (
+	ScriptTimeout = 2 * time.Second
+	// ScriptMaxSteps bounds the Starlark instructions a run may execute.
+	ScriptMaxSteps = 10_000_000
+	// ScriptMemoryLimit bounds how much the heap may grow while a run is in progress.
+	// Runs are serialized so the growth is the script's; the check is sampled, so a
+	// single large allocation may overshoot it before the run is cancelled.
+	ScriptMemoryLimit = 64 << 20
+	// MaxScriptOutputLines is how many print() lines a run keeps.
+	MaxScriptOutputLines = 200
+)
+
+// Script is a user script found in the scripts folder.
+//
+// What: A Starlark file and the functions it defines. Functions named on_<kind>
+// are activity hooks; functions named command_<name> are palette commands.
+// Why: Power users customize workflows beyond what automation rules offer without
+// forking the app.
+// When: Listed from the scripts folder on demand; files are re-read on every run,
+// so edits apply without a restart. Hooks run in the background after the change
+// that triggered them.
+type Script struct {
+	Name      string   `json:"name"`
+	Functions []string `json:"functions"`
+	// Error says why the script failed to compile.
+	Error string `json:"error,omitempty"`
+	// LastError is the most recent hook failure since the app started.
+	LastError string `json:"last_error,omitempty"`
+}
+
+// ScriptCommand is a script function offered in the command palette.
+type ScriptCommand struct {
+	Script   string `json:"script"`
+	Function string `json:"function"`
+	// Label is the function name without its prefix, with underscores as spaces.
+	Label string `json:"label"`
+}
+
+// ScriptResult is the outcome of a script run.
+type ScriptResult struct {
+	// Value is what the called function returned, as a JSON-like value.
+	Value  any      `json:"value"`
+	Output []string `json:"output"`
+}
+
+// ScriptFunc is a function of the API given to scripts. Arguments and results are
+// JSON-like values: nil, bool, int64, float64, string, []any and map[string]any.
+type ScriptFunc struct {
+	// Params names the parameters in positional order; a trailing "?" marks an optional one.
+	Params []string
+	// Call receives the arguments the script passed, keyed by parameter name.
+	Call func(ctx context.Context, args map[string]any) (any, error)
+}
+
+// ScriptEngine loads and runs user scripts in a sandbox without file, network or
+// process access, enforcing the script limits above. Failures wrap ErrScriptFailed.
+type ScriptEngine interface {
+	// List compiles every script without running it and returns the functions each
+	// defines with a top-level def. A script that fails to compile is listed with
+	// Error set.
+	List(ctx context.Context) ([]Script, error)
+	// Call runs a script's top level, then calls its function fn with arg as the only
+	// argument. api is available to fn as the kanban module; the top level sees the
+	// module empty.
+	Call(ctx context.Context, script, fn string, arg map[string]any, api map[string]ScriptFunc) (*ScriptResult, error)
+}
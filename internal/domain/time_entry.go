@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Time tracking limits.
+const (
+	MaxTimeNoteLength = 500
+	// MaxTimeEntryDuration bounds a single manual entry.
+	MaxTimeEntryDuration = 24 * time.Hour
+)
+
+// TimeEntry is a span of time worked on a card.
+//
+// What: Either a timer (started from the card, EndedAt nil while it runs) or a manual entry
+// with both ends given; Seconds is the elapsed time, up to now for a running timer.
+// Why: Work is billed by the hour, so time spent has to be attributable to cards.
+// When: At most one timer runs at a time app-wide; starting another stops it. Entries are
+// persisted immediately, so a running timer survives restarts. A manual entry may not overlap
+// another entry on the same card. Deleting a card deletes its entries.
+type TimeEntry struct {
+	ID        string     `json:"id"`
+	CardID    string     `json:"card_id"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at"`
+	Seconds   int64      `json:"seconds"`
+	Note      string     `json:"note"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// Running reports whether e is a timer that has not been stopped.
+func (e *TimeEntry) Running() bool {
+	return e.EndedAt == nil
+}
+
+// TimeEntryInput is a manual time entry. StartedAt and EndedAt are RFC3339.
+type TimeEntryInput struct {
+	StartedAt string `json:"started_at"`
+	EndedAt   string `json:"ended_at"`
+	Note      string `json:"note"`
+}
+
+// TimeEntryRepository defines persistence operations for time entries.
+type TimeEntryRepository interface {
+	GetByID(ctx context.Context, id string) (*TimeEntry, error)
+	// GetRunning returns the running timer, or ErrNotFound when none is running.
+	GetRunning(ctx context.Context) (*TimeEntry, error)
+	Create(ctx context.Context, e *TimeEntry) error
+	Stop(ctx context.Context, id string, at time.Time) error
+	Delete(ctx context.Context, id string) error
+	ListByCard(ctx context.Context, cardID string) ([]TimeEntry, error)
+	// ListByBoard lists entries for cards currently on boardID that started in [from, to),
+	// oldest first. A zero from or to leaves that end open.
+	ListByBoard(ctx context.Context, boardID string, from, to time.Time) ([]TimeEntry, error)
+}
+
+// CardTimeLog is every time entry on one card with their total.
+type CardTimeLog struct {
+	CardID       string      `json:"card_id"`
+	TotalSeconds int64       `json:"total_seconds"`
+	Entries      []TimeEntry `json:"entries"`
+}
+
+// TimeReport totals the time logged on a board's cards.
+//
+// What: The board total plus breakdowns per card and per day, for entries that started
+// between From and To (YYYY-MM-DD, inclusive; empty means open-ended).
+// Why: Invoices are built per period; per-card totals show where the hours went.
+// When: Computed on request. Entries count on the local day they started; a running
+// timer counts up to now.
+type TimeReport struct {
+	BoardID      string     `json:"board_id"`
+	From         string     `json:"from"`
+	To           string     `json:"to"`
+	TotalSeconds int64      `json:"total_seconds"`
+	ByCard       []CardTime `json:"by_card"`
+	ByDate       []DateTime `json:"by_date"`
+}
+
+// CardTime is the time logged on one card within a report.
+type CardTime struct {
+	CardID  string `json:"card_id"`
+	Title   string `json:"title"`
+	Seconds int64  `json:"seconds"`
+}
+
+// DateTime is the time logged on one day (YYYY-MM-DD) within a report.
+type DateTime struct {
+	Date    string `json:"date"`
+	Seconds int64  `json:"seconds"`
+}
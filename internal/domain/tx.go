@@ -0,0 +1,13 @@
+package domain
+
+import "context"
+
+// Transactor runs a unit of work atomically.
+//
+// What: Repository calls made with the ctx passed to fn share a single transaction.
+// Why: Multi-step operations (e.g. deep copies) must not leave half-written data behind.
+// When: Used by services whose writes span several rows or repositories.
+type Transactor interface {
+	// WithinTx commits if fn returns nil and rolls back otherwise. Nested calls join the outer transaction.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
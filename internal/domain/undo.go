@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Undo entry kinds, one per bulk card operation.
+const (
+	UndoBulkUpdate  = "bulk_update"
+	UndoBulkMove    = "bulk_move"
+	UndoBulkDelete  = "bulk_delete"
+	UndoBulkArchive = "bulk_archive"
+)
+
+// MaxUndoEntries is how many undo entries are kept; older ones are dropped.
+const MaxUndoEntries = 20
+
+// UndoEntry records how to reverse one bulk card operation.
+//
+// What: Snapshots of every card the operation changed, taken before it ran.
+// Why: A bulk action touches dozens of cards at once; a mistake should be reversible
+// in one step rather than card by card.
+// When: Recorded by the CardService.Bulk* methods in the same transaction as the
+// change. Undo restores the most recent entry, records compensating card history in
+// the same transaction and removes the entry. The time entries of deleted cards and
+// side effects of automations or script hooks are not restored.
+type UndoEntry struct {
+	ID        string    `json:"id"`
+	Kind      string    `json:"kind"`
+	Cards     []Card    `json:"cards"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// UndoRepository defines persistence operations for the undo stack.
+type UndoRepository interface {
+	// Push records e and drops entries beyond MaxUndoEntries, oldest first.
+	Push(ctx context.Context, e *UndoEntry) error
+	// Latest returns the most recent entry, or ErrNotFound when there is none.
+	Latest(ctx context.Context) (*UndoEntry, error)
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+	"slices"
+)
+
+// Workflow holds a board's column transition rules.
+//
+// What: For each restricted column, the columns its cards may move to. Columns
+// without an entry are unrestricted, and reordering within a column is always allowed.
+// Why: Some boards must not let work skip a stage such as review.
+// When: Edited from the board settings; enforced by CardService.Move and BulkMove
+// unless the move is an admin override. Deleting a column drops its rules.
+type Workflow struct {
+	BoardID     string              `json:"board_id"`
+	Transitions map[string][]string `json:"transitions"`
+}
+
+// Allows reports whether a card may move from column from to column to.
+func (w *Workflow) Allows(from, to string) bool {
+	if from == to {
+		return true
+	}
+	targets, ok := w.Transitions[from]
+	return !ok || slices.Contains(targets, to)
+}
+
+// WorkflowRepository defines persistence for board workflows.
+type WorkflowRepository interface {
+	// GetByBoardID returns the board's workflow; a board without rules has an empty one.
+	GetByBoardID(ctx context.Context, boardID string) (*Workflow, error)
+	// Replace swaps the board's rules for w.Transitions.
+	Replace(ctx context.Context, w *Workflow) error
+}
@@ -0,0 +1,17 @@
+package desktop
+
+import "github.com/gen2brain/beeep"
+
+// Notifier shows native desktop notifications. Wails v2 has no notification
+// API, so this goes through the platform's own notification service
+// (Notification Center, toast, or D-Bus).
+type Notifier struct{}
+
+func NewNotifier() *Notifier {
+	beeep.AppName = "Kanban Board"
+	return &Notifier{}
+}
+
+func (n *Notifier) Notify(title, message string) error {
+	return beeep.Notify(title, message, "")
+}
@@ -0,0 +1,12 @@
+package desktop
+
+import (
+	"github.com/google/wire"
+
+	"kanban-app-playground/internal/domain"
+)
+
+var NotifierSet = wire.NewSet(
+	NewNotifier,
+	wire.Bind(new(domain.Notifier), new(*Notifier)),
+)
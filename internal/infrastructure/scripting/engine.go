@@ -0,0 +1,267 @@
+package scripting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"runtime/metrics"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"go.starlark.net/syntax"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// memoryCheckInterval is how often a run's heap growth is sampled.
+const memoryCheckInterval = 5 * time.Millisecond
+
+// heapMetric is the live-and-unswept heap size, sampled to enforce ScriptMemoryLimit.
+const heapMetric = "/memory/classes/heap/objects:bytes"
+
+// fileOptions enables the Starlark dialect features scripts may use. Recursion stays
+// off; loops are bounded by ScriptMaxSteps.
+var fileOptions = &syntax.FileOptions{Set: true, While: true, TopLevelControl: true, GlobalReassign: true}
+
+// Engine runs Starlark scripts from the scripts folder under the config directory.
+// Starlark has no built-in file, network or process access, and load() is disabled,
+// so scripts can only reach the app through the API they are given.
+type Engine struct {
+	dir string
+
+	// mu runs one script at a time, so heap growth during a run is attributable to it.
+	mu sync.Mutex
+}
+
+func NewEngine() (*Engine, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("get config dir: %w", err)
+	}
+	dir := filepath.Join(configDir, "KanbanApp", "scripts")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create scripts dir: %w", err)
+	}
+	return &Engine{dir: dir}, nil
+}
+
+// List parses every script without running it, so listing has no side effects.
+func (e *Engine) List(_ context.Context) ([]domain.Script, error) {
+	entries, err := os.ReadDir(e.dir)
+	if err != nil {
+		return nil, fmt.Errorf("read scripts dir: %w", err)
+	}
+
+	var scripts []domain.Script
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != domain.ScriptExt {
+			continue
+		}
+		s := domain.Script{Name: entry.Name(), Functions: []string{}}
+		if fns, err := e.functions(entry.Name()); err != nil {
+			s.Error = err.Error()
+		} else {
+			s.Functions = fns
+		}
+		scripts = append(scripts, s)
+	}
+	return scripts, nil
+}
+
+// functions compiles script and returns the names of its top-level def statements,
+// sorted. Compiling catches syntax errors and undefined names without executing code.
+func (e *Engine) functions(script string) ([]string, error) {
+	src, err := os.ReadFile(filepath.Join(e.dir, script))
+	if err != nil {
+		return nil, fmt.Errorf("read script: %w", err)
+	}
+	isPredeclared := func(name string) bool { return name == "kanban" }
+	f, _, err := starlark.SourceProgramOptions(fileOptions, script, src, isPredeclared)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrScriptFailed, err)
+	}
+	fns := []string{}
+	for _, stmt := range f.Stmts {
+		if def, ok := stmt.(*syntax.DefStmt); ok {
+			fns = append(fns, def.Name.Name)
+		}
+	}
+	slices.Sort(fns)
+	return fns, nil
+}
+
+func (e *Engine) Call(ctx context.Context, script, fn string, arg map[string]any, api map[string]domain.ScriptFunc) (*domain.ScriptResult, error) {
+	return e.run(ctx, script, api, func(thread *starlark.Thread, globals starlark.StringDict) (starlark.Value, error) {
+		f, ok := globals[fn].(*starlark.Function)
+		if !ok {
+			return nil, fmt.Errorf("function %s in %s: %w", fn, script, domain.ErrNotFound)
+		}
+		sarg, err := toStarlark(arg)
+		if err != nil {
+			return nil, err
+		}
+		return starlark.Call(thread, f, starlark.Tuple{sarg}, nil)
+	})
+}
+
+// run executes a script's top level under the sandbox limits and then hands its
+// globals to call, whose result becomes the run's value.
+func (e *Engine) run(
+	ctx context.Context, script string, api map[string]domain.ScriptFunc,
+	call func(*starlark.Thread, starlark.StringDict) (starlark.Value, error),
+) (*domain.ScriptResult, error) {
+	if script != filepath.Base(script) || filepath.Ext(script) != domain.ScriptExt {
+		return nil, fmt.Errorf("script %s: %w", script, domain.ErrNotFound)
+	}
+	src, err := os.ReadFile(filepath.Join(e.dir, script))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("script %s: %w", script, domain.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read script: %w", err)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, domain.ScriptTimeout)
+	defer cancel()
+
+	res := &domain.ScriptResult{Output: []string{}}
+	thread := &starlark.Thread{
+		Name: script,
+		Print: func(_ *starlark.Thread, msg string) {
+			if len(res.Output) < domain.MaxScriptOutputLines {
+				res.Output = append(res.Output, msg)
+			}
+		},
+		Load: func(*starlark.Thread, string) (starlark.StringDict, error) {
+			return nil, errors.New("load is not available to scripts")
+		},
+	}
+	thread.SetMaxExecutionSteps(domain.ScriptMaxSteps)
+	stop := context.AfterFunc(ctx, func() { thread.Cancel("timed out after " + domain.ScriptTimeout.String()) })
+	defer stop()
+	done := make(chan struct{})
+	defer close(done)
+	go watchMemory(thread, done)
+
+	// The top level sees an empty kanban module and the API is filled in before call,
+	// so loading a script cannot change data.
+	kanban := &starlarkstruct.Module{Name: "kanban", Members: starlark.StringDict{}}
+	globals, err := starlark.ExecFileOptions(fileOptions, thread, script, src, starlark.StringDict{"kanban": kanban})
+	var value starlark.Value = starlark.None
+	if err == nil {
+		maps.Copy(kanban.Members, apiMembers(ctx, api))
+		value, err = call(thread, globals)
+	}
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, err
+		}
+		var evalErr *starlark.EvalError
+		if errors.As(err, &evalErr) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrScriptFailed, evalErr.Backtrace())
+		}
+		return nil, fmt.Errorf("%w: %v", domain.ErrScriptFailed, err)
+	}
+	if res.Value, err = fromStarlark(value); err != nil {
+		return nil, fmt.Errorf("%w: %s returned %v", domain.ErrScriptFailed, script, err)
+	}
+	return res, nil
+}
+
+// watchMemory cancels thread once the heap has grown by more than ScriptMemoryLimit
+// since the run started, until done is closed. Cancellation takes effect at the
+// thread's next step, so a single operation can still allocate up to Starlark's own
+// per-value ceiling (1 GiB) before the run stops.
+func watchMemory(thread *starlark.Thread, done <-chan struct{}) {
+	sample := []metrics.Sample{{Name: heapMetric}}
+	metrics.Read(sample)
+	base := sample[0].Value.Uint64()
+
+	ticker := time.NewTicker(memoryCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+		metrics.Read(sample)
+		if used := sample[0].Value.Uint64(); used > base && used-base > domain.ScriptMemoryLimit {
+			thread.Cancel(fmt.Sprintf("memory limit of %d MiB exceeded", domain.ScriptMemoryLimit>>20))
+			return
+		}
+	}
+}
+
+// apiMembers wraps api as members of the kanban module; each function runs with ctx.
+func apiMembers(ctx context.Context, api map[string]domain.ScriptFunc) starlark.StringDict {
+	members := make(starlark.StringDict, len(api))
+	for name, f := range api {
+		members[name] = starlark.NewBuiltin(name, func(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			in, err := bindArgs(b.Name(), f.Params, args, kwargs)
+			if err != nil {
+				return nil, err
+			}
+			out, err := f.Call(ctx, in)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", b.Name(), err)
+			}
+			return toStarlark(out)
+		})
+	}
+	return members
+}
+
+// bindArgs maps a call's positional and keyword arguments onto params.
+func bindArgs(fn string, params []string, args starlark.Tuple, kwargs []starlark.Tuple) (map[string]any, error) {
+	names := make([]string, len(params))
+	for i, p := range params {
+		names[i] = strings.TrimSuffix(p, "?")
+	}
+	if len(args) > len(params) {
+		return nil, fmt.Errorf("%s: got %d arguments, want at most %d", fn, len(args), len(params))
+	}
+
+	out := make(map[string]any, len(params))
+	set := func(name string, v starlark.Value) error {
+		if _, dup := out[name]; dup {
+			return fmt.Errorf("%s: got multiple values for %s", fn, name)
+		}
+		gv, err := fromStarlark(v)
+		if err != nil {
+			return fmt.Errorf("%s: %s: %w", fn, name, err)
+		}
+		out[name] = gv
+		return nil
+	}
+	for i, v := range args {
+		if err := set(names[i], v); err != nil {
+			return nil, err
+		}
+	}
+	for _, kv := range kwargs {
+		name := string(kv[0].(starlark.String))
+		if !slices.Contains(names, name) {
+			return nil, fmt.Errorf("%s: unexpected keyword argument %s", fn, name)
+		}
+		if err := set(name, kv[1]); err != nil {
+			return nil, err
+		}
+	}
+	for i, p := range params {
+		if _, ok := out[names[i]]; !ok && !strings.HasSuffix(p, "?") {
+			return nil, fmt.Errorf("%s: missing argument %s", fn, names[i])
+		}
+	}
+	return out, nil
+}
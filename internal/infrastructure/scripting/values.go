@@ -0,0 +1,114 @@
+package scripting
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"go.starlark.net/starlark"
+)
+
+// toStarlark converts a JSON-like Go value to Starlark. Whole float64 values, as
+// produced by encoding/json, become ints.
+func toStarlark(v any) (starlark.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return starlark.None, nil
+	case bool:
+		return starlark.Bool(v), nil
+	case string:
+		return starlark.String(v), nil
+	case int:
+		return starlark.MakeInt(v), nil
+	case int64:
+		return starlark.MakeInt64(v), nil
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+			return starlark.MakeInt64(int64(v)), nil
+		}
+		return starlark.Float(v), nil
+	case []any:
+		elems := make([]starlark.Value, len(v))
+		for i, e := range v {
+			sv, err := toStarlark(e)
+			if err != nil {
+				return nil, err
+			}
+			elems[i] = sv
+		}
+		return starlark.NewList(elems), nil
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		d := starlark.NewDict(len(v))
+		for _, k := range keys {
+			sv, err := toStarlark(v[k])
+			if err != nil {
+				return nil, err
+			}
+			if err := d.SetKey(starlark.String(k), sv); err != nil {
+				return nil, err
+			}
+		}
+		return d, nil
+	}
+	return nil, fmt.Errorf("cannot pass %T to a script", v)
+}
+
+// fromStarlark converts a Starlark value to a JSON-like Go value. Only None, bools,
+// numbers, strings, lists, tuples and dicts with string keys are allowed.
+func fromStarlark(v starlark.Value) (any, error) {
+	switch v := v.(type) {
+	case starlark.NoneType:
+		return nil, nil
+	case starlark.Bool:
+		return bool(v), nil
+	case starlark.String:
+		return string(v), nil
+	case starlark.Int:
+		n, ok := v.Int64()
+		if !ok {
+			return nil, fmt.Errorf("int %s out of range", v)
+		}
+		return n, nil
+	case starlark.Float:
+		return float64(v), nil
+	case *starlark.List:
+		return fromIterable(v, v.Len())
+	case starlark.Tuple:
+		return fromIterable(v, v.Len())
+	case *starlark.Dict:
+		out := make(map[string]any, v.Len())
+		for _, item := range v.Items() {
+			k, ok := item[0].(starlark.String)
+			if !ok {
+				return nil, fmt.Errorf("dict key %s is not a string", item[0])
+			}
+			gv, err := fromStarlark(item[1])
+			if err != nil {
+				return nil, err
+			}
+			out[string(k)] = gv
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("unsupported value of type %s", v.Type())
+}
+
+func fromIterable(v starlark.Iterable, n int) ([]any, error) {
+	out := make([]any, 0, n)
+	it := v.Iterate()
+	defer it.Done()
+	var e starlark.Value
+	for it.Next(&e) {
+		gv, err := fromStarlark(e)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, gv)
+	}
+	return out, nil
+}
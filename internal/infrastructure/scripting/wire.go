@@ -0,0 +1,12 @@
+package scripting
+
+import (
+	"github.com/google/wire"
+
+	"kanban-app-playground/internal/domain"
+)
+
+var EngineSet = wire.NewSet(
+	NewEngine,
+	wire.Bind(new(domain.ScriptEngine), new(*Engine)),
+)
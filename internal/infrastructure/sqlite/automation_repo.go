@@ -0,0 +1,235 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+const automationSelect = `id, board_id, name, enabled, trigger_kind, trigger_column_id, conditions, actions, created_at, updated_at`
+
+type AutomationRepo struct {
+	db *sql.DB
+}
+
+func NewAutomationRepo(db *DB) *AutomationRepo {
+	return &AutomationRepo{db: db.DB}
+}
+
+func scanAutomation(sc interface{ Scan(dest ...any) error }) (domain.AutomationRule, error) {
+	var a domain.AutomationRule
+	var conditions, actions, createdAt, updatedAt string
+	if err := sc.Scan(
+		&a.ID, &a.BoardID, &a.Name, &a.Enabled, &a.Trigger.Kind, &a.Trigger.ColumnID,
+		&conditions, &actions, &createdAt, &updatedAt,
+	); err != nil {
+		return a, err
+	}
+	if err := json.Unmarshal([]byte(conditions), &a.Conditions); err != nil {
+		return a, fmt.Errorf("parse conditions: %w", err)
+	}
+	if err := json.Unmarshal([]byte(actions), &a.Actions); err != nil {
+		return a, fmt.Errorf("parse actions: %w", err)
+	}
+	if a.Conditions == nil {
+		a.Conditions = []domain.AutomationCondition{}
+	}
+	if a.Actions == nil {
+		a.Actions = []domain.AutomationAction{}
+	}
+	var err error
+	if a.CreatedAt, err = parseTime(createdAt); err != nil {
+		return a, fmt.Errorf("parse created_at: %w", err)
+	}
+	if a.UpdatedAt, err = parseTime(updatedAt); err != nil {
+		return a, fmt.Errorf("parse updated_at: %w", err)
+	}
+	return a, nil
+}
+
+func (r *AutomationRepo) list(ctx context.Context, where string, args ...any) ([]domain.AutomationRule, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		"SELECT "+automationSelect+" FROM automation_rules WHERE "+where+" ORDER BY created_at ASC, id ASC", args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query automation rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []domain.AutomationRule
+	for rows.Next() {
+		a, err := scanAutomation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan automation rule: %w", err)
+		}
+		rules = append(rules, a)
+	}
+	return rules, rows.Err()
+}
+
+func (r *AutomationRepo) GetByBoardID(ctx context.Context, boardID string) ([]domain.AutomationRule, error) {
+	return r.list(ctx, "board_id = ?", boardID)
+}
+
+func (r *AutomationRepo) ListEnabled(ctx context.Context, triggerKind string) ([]domain.AutomationRule, error) {
+	return r.list(ctx, "enabled = 1 AND trigger_kind = ?", triggerKind)
+}
+
+func (r *AutomationRepo) GetByID(ctx context.Context, id string) (*domain.AutomationRule, error) {
+	row := conn(ctx, r.db).QueryRowContext(ctx,
+		"SELECT "+automationSelect+" FROM automation_rules WHERE id = ?", id,
+	)
+	a, err := scanAutomation(row)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("automation rule %s: %w", id, domain.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query automation rule: %w", err)
+	}
+	return &a, nil
+}
+
+// encodeAutomation returns a rule's conditions and actions as JSON.
+func encodeAutomation(a *domain.AutomationRule) (string, string, error) {
+	conditions, err := json.Marshal(a.Conditions)
+	if err != nil {
+		return "", "", fmt.Errorf("encode conditions: %w", err)
+	}
+	actions, err := json.Marshal(a.Actions)
+	if err != nil {
+		return "", "", fmt.Errorf("encode actions: %w", err)
+	}
+	return string(conditions), string(actions), nil
+}
+
+func (r *AutomationRepo) Create(ctx context.Context, a *domain.AutomationRule) error {
+	conditions, actions, err := encodeAutomation(a)
+	if err != nil {
+		return err
+	}
+	_, err = conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO automation_rules (`+automationSelect+`)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		a.ID, a.BoardID, a.Name, a.Enabled, a.Trigger.Kind, a.Trigger.ColumnID,
+		conditions, actions, formatTime(a.CreatedAt), formatTime(a.UpdatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("insert automation rule: %w", err)
+	}
+	return nil
+}
+
+func (r *AutomationRepo) Update(ctx context.Context, a *domain.AutomationRule) error {
+	conditions, actions, err := encodeAutomation(a)
+	if err != nil {
+		return err
+	}
+	res, err := conn(ctx, r.db).ExecContext(ctx,
+		`UPDATE automation_rules
+		 SET name = ?, enabled = ?, trigger_kind = ?, trigger_column_id = ?, conditions = ?, actions = ?, updated_at = ?
+		 WHERE id = ?`,
+		a.Name, a.Enabled, a.Trigger.Kind, a.Trigger.ColumnID, conditions, actions, formatTime(a.UpdatedAt), a.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("update automation rule: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("automation rule %s: %w", a.ID, domain.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *AutomationRepo) Delete(ctx context.Context, id string) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM automation_rules WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("delete automation rule: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("automation rule %s: %w", id, domain.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *AutomationRepo) LogRun(ctx context.Context, run *domain.AutomationRun) error {
+	tx := &Transactor{db: r.db}
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		_, err := conn(ctx, r.db).ExecContext(ctx,
+			`INSERT INTO automation_runs (id, rule_id, board_id, card_id, trigger_kind, status, message, ran_at)
+			 VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			run.ID, run.RuleID, run.BoardID, run.CardID, run.Trigger, run.Status, run.Message, formatTime(run.RanAt),
+		)
+		if err != nil {
+			return fmt.Errorf("insert automation run: %w", err)
+		}
+		_, err = conn(ctx, r.db).ExecContext(ctx,
+			`DELETE FROM automation_runs
+			 WHERE board_id = ? AND rowid NOT IN (
+			     SELECT rowid FROM automation_runs WHERE board_id = ?
+			     ORDER BY ran_at DESC, rowid DESC LIMIT ?)`,
+			run.BoardID, run.BoardID, domain.MaxAutomationRuns,
+		)
+		if err != nil {
+			return fmt.Errorf("prune automation runs: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *AutomationRepo) Runs(ctx context.Context, boardID string, limit int) ([]domain.AutomationRun, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		`SELECT ar.id, ar.rule_id, a.name, ar.board_id, ar.card_id, ar.trigger_kind, ar.status, ar.message, ar.ran_at
+		 FROM automation_runs ar
+		 JOIN automation_rules a ON ar.rule_id = a.id
+		 WHERE ar.board_id = ?
+		 ORDER BY ar.ran_at DESC, ar.rowid DESC
+		 LIMIT ?`, boardID, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query automation runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []domain.AutomationRun
+	for rows.Next() {
+		var run domain.AutomationRun
+		var ranAt string
+		if err := rows.Scan(
+			&run.ID, &run.RuleID, &run.RuleName, &run.BoardID, &run.CardID, &run.Trigger, &run.Status, &run.Message, &ranAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan automation run: %w", err)
+		}
+		if run.RanAt, err = parseTime(ranAt); err != nil {
+			return nil, fmt.Errorf("parse ran_at: %w", err)
+		}
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
+
+func (r *AutomationRepo) DueFired(ctx context.Context, ruleID, cardID string, due time.Time) (bool, error) {
+	var n int
+	err := conn(ctx, r.db).QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM automation_due_fired WHERE rule_id = ? AND card_id = ? AND due_date = ?",
+		ruleID, cardID, formatTime(due),
+	).Scan(&n)
+	if err != nil {
+		return false, fmt.Errorf("query automation_due_fired: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (r *AutomationRepo) MarkDueFired(ctx context.Context, ruleID, cardID string, due time.Time) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO automation_due_fired (rule_id, card_id, due_date) VALUES (?, ?, ?)
+		 ON CONFLICT(rule_id, card_id) DO UPDATE SET due_date = excluded.due_date`,
+		ruleID, cardID, formatTime(due),
+	)
+	if err != nil {
+		return fmt.Errorf("insert automation_due_fired: %w", err)
+	}
+	return nil
+}
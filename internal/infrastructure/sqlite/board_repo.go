@@ -3,7 +3,9 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"kanban-app-playground/internal/domain"
 )
@@ -16,12 +18,23 @@ func NewBoardRepo(db *DB) *BoardRepo {
 	return &BoardRepo{db: db.DB}
 }
 
+// boardSelect lists the board columns read by scanBoard; queries must not alias boards.
+const boardSelect = `id, title, COALESCE(start_column_id, ''), COALESCE(done_column_id, ''), estimate_scale,
+        created_at, updated_at,
+        (SELECT COALESCE(json_group_array(json_object('key', key, 'name', name, 'color', color, 'weight', weight)), '[]')
+         FROM (SELECT * FROM board_priorities WHERE board_id = boards.id ORDER BY weight))`
+
 func scanBoard(sc interface{ Scan(dest ...any) error }) (domain.Board, error) {
 	var b domain.Board
-	var createdAt, updatedAt string
-	if err := sc.Scan(&b.ID, &b.Title, &createdAt, &updatedAt); err != nil {
+	var createdAt, updatedAt, priorities string
+	if err := sc.Scan(
+		&b.ID, &b.Title, &b.StartColumnID, &b.DoneColumnID, &b.EstimateScale, &createdAt, &updatedAt, &priorities,
+	); err != nil {
 		return b, err
 	}
+	if err := json.Unmarshal([]byte(priorities), &b.Priorities); err != nil {
+		return b, fmt.Errorf("parse priorities: %w", err)
+	}
 	var err error
 	if b.CreatedAt, err = parseTime(createdAt); err != nil {
 		return b, fmt.Errorf("parse created_at: %w", err)
@@ -33,8 +46,8 @@ func scanBoard(sc interface{ Scan(dest ...any) error }) (domain.Board, error) {
 }
 
 func (r *BoardRepo) GetAll(ctx context.Context) ([]domain.Board, error) {
-	rows, err := r.db.QueryContext(ctx,
-		"SELECT id, title, created_at, updated_at FROM boards ORDER BY created_at ASC",
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		"SELECT "+boardSelect+" FROM boards ORDER BY created_at ASC",
 	)
 	if err != nil {
 		return nil, fmt.Errorf("query boards: %w", err)
@@ -53,8 +66,8 @@ func (r *BoardRepo) GetAll(ctx context.Context) ([]domain.Board, error) {
 }
 
 func (r *BoardRepo) GetByID(ctx context.Context, id string) (*domain.Board, error) {
-	row := r.db.QueryRowContext(ctx,
-		"SELECT id, title, created_at, updated_at FROM boards WHERE id = ?", id,
+	row := conn(ctx, r.db).QueryRowContext(ctx,
+		"SELECT "+boardSelect+" FROM boards WHERE id = ?", id,
 	)
 	b, err := scanBoard(row)
 	if err == sql.ErrNoRows {
@@ -66,19 +79,69 @@ func (r *BoardRepo) GetByID(ctx context.Context, id string) (*domain.Board, erro
 	return &b, nil
 }
 
+// Create inserts board along with its priority scheme.
 func (r *BoardRepo) Create(ctx context.Context, board *domain.Board) error {
-	_, err := r.db.ExecContext(ctx,
-		"INSERT INTO boards (id, title, created_at, updated_at) VALUES (?, ?, ?, ?)",
-		board.ID, board.Title, formatTime(board.CreatedAt), formatTime(board.UpdatedAt),
-	)
-	if err != nil {
-		return fmt.Errorf("insert board: %w", err)
+	tx := &Transactor{db: r.db}
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		_, err := conn(ctx, r.db).ExecContext(ctx,
+			"INSERT INTO boards (id, title, estimate_scale, created_at, updated_at) VALUES (?, ?, ?, ?, ?)",
+			board.ID, board.Title, board.EstimateScale, formatTime(board.CreatedAt), formatTime(board.UpdatedAt),
+		)
+		if err != nil {
+			return fmt.Errorf("insert board: %w", err)
+		}
+		return r.insertPriorities(ctx, board.ID, board.Priorities)
+	})
+}
+
+func (r *BoardRepo) insertPriorities(ctx context.Context, boardID string, levels []domain.PriorityLevel) error {
+	for _, l := range levels {
+		if _, err := conn(ctx, r.db).ExecContext(ctx,
+			"INSERT INTO board_priorities (board_id, key, name, color, weight) VALUES (?, ?, ?, ?, ?)",
+			boardID, l.Key, l.Name, l.Color, l.Weight,
+		); err != nil {
+			return fmt.Errorf("insert priority: %w", err)
+		}
 	}
 	return nil
 }
 
+func (r *BoardRepo) SetPriorities(ctx context.Context, id string, levels []domain.PriorityLevel, remap map[string]string) error {
+	tx := &Transactor{db: r.db}
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		res, err := conn(ctx, r.db).ExecContext(ctx,
+			"UPDATE boards SET updated_at = ? WHERE id = ?", formatTime(time.Now().UTC()), id,
+		)
+		if err != nil {
+			return fmt.Errorf("touch board: %w", err)
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return fmt.Errorf("board %s: %w", id, domain.ErrNotFound)
+		}
+		if _, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM board_priorities WHERE board_id = ?", id); err != nil {
+			return fmt.Errorf("clear priorities: %w", err)
+		}
+		if err := r.insertPriorities(ctx, id, levels); err != nil {
+			return err
+		}
+		for from, to := range remap {
+			for _, q := range []string{
+				`UPDATE cards SET priority = ?
+				 WHERE priority = ? AND column_id IN (SELECT id FROM columns WHERE board_id = ?)`,
+				"UPDATE card_templates SET priority = ? WHERE priority = ? AND board_id = ?",
+				"UPDATE recurrences SET priority = ? WHERE priority = ? AND board_id = ?",
+			} {
+				if _, err := conn(ctx, r.db).ExecContext(ctx, q, to, from, id); err != nil {
+					return fmt.Errorf("remap priority %s: %w", from, err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
 func (r *BoardRepo) Update(ctx context.Context, board *domain.Board) error {
-	res, err := r.db.ExecContext(ctx,
+	res, err := conn(ctx, r.db).ExecContext(ctx,
 		"UPDATE boards SET title = ?, updated_at = ? WHERE id = ?",
 		board.Title, formatTime(board.UpdatedAt), board.ID,
 	)
@@ -91,8 +154,36 @@ func (r *BoardRepo) Update(ctx context.Context, board *domain.Board) error {
 	return nil
 }
 
+func (r *BoardRepo) SetFlowColumns(ctx context.Context, id, startColumnID, doneColumnID string) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx,
+		"UPDATE boards SET start_column_id = ?, done_column_id = ?, updated_at = ? WHERE id = ?",
+		nullIfEmpty(startColumnID), nullIfEmpty(doneColumnID), formatTime(time.Now().UTC()), id,
+	)
+	if err != nil {
+		return fmt.Errorf("set flow columns: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("board %s: %w", id, domain.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *BoardRepo) SetEstimateScale(ctx context.Context, id, kind string) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx,
+		"UPDATE boards SET estimate_scale = ?, updated_at = ? WHERE id = ?",
+		kind, formatTime(time.Now().UTC()), id,
+	)
+	if err != nil {
+		return fmt.Errorf("set estimate scale: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("board %s: %w", id, domain.ErrNotFound)
+	}
+	return nil
+}
+
 func (r *BoardRepo) Delete(ctx context.Context, id string) error {
-	res, err := r.db.ExecContext(ctx, "DELETE FROM boards WHERE id = ?", id)
+	res, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM boards WHERE id = ?", id)
 	if err != nil {
 		return fmt.Errorf("delete board: %w", err)
 	}
@@ -0,0 +1,117 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// BoardTemplateRepo stores user-defined templates; columns and cards are kept as a JSON blob.
+type BoardTemplateRepo struct {
+	db *sql.DB
+}
+
+func NewBoardTemplateRepo(db *DB) *BoardTemplateRepo {
+	return &BoardTemplateRepo{db: db.DB}
+}
+
+// templateBody is the JSON-encoded part of a stored template. Templates saved before
+// priorities, fields, transitions, the estimate scale and flow columns were captured
+// decode with those empty.
+type templateBody struct {
+	Columns       []domain.TemplateColumn   `json:"columns"`
+	Cards         []domain.TemplateCard     `json:"cards"`
+	Priorities    []domain.PriorityLevel    `json:"priorities,omitempty"`
+	Fields        []domain.CustomFieldInput `json:"fields,omitempty"`
+	Transitions   map[int][]int             `json:"transitions,omitempty"`
+	EstimateScale string                    `json:"estimate_scale,omitempty"`
+	StartColumn   *int                      `json:"start_column,omitempty"`
+	DoneColumn    *int                      `json:"done_column,omitempty"`
+}
+
+func scanBoardTemplate(sc interface{ Scan(dest ...any) error }) (domain.BoardTemplate, error) {
+	var t domain.BoardTemplate
+	var body, createdAt string
+	if err := sc.Scan(&t.ID, &t.Name, &t.Description, &body, &createdAt); err != nil {
+		return t, err
+	}
+	var b templateBody
+	if err := json.Unmarshal([]byte(body), &b); err != nil {
+		return t, fmt.Errorf("decode template body: %w", err)
+	}
+	t.Columns, t.Cards = b.Columns, b.Cards
+	t.Priorities, t.Fields, t.Transitions = b.Priorities, b.Fields, b.Transitions
+	t.EstimateScale, t.StartColumn, t.DoneColumn = b.EstimateScale, b.StartColumn, b.DoneColumn
+	var err error
+	if t.CreatedAt, err = parseTime(createdAt); err != nil {
+		return t, fmt.Errorf("parse created_at: %w", err)
+	}
+	return t, nil
+}
+
+func (r *BoardTemplateRepo) GetAll(ctx context.Context) ([]domain.BoardTemplate, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		"SELECT id, name, description, body, created_at FROM board_templates ORDER BY created_at ASC",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query board templates: %w", err)
+	}
+	defer rows.Close()
+
+	var tpls []domain.BoardTemplate
+	for rows.Next() {
+		t, err := scanBoardTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan board template: %w", err)
+		}
+		tpls = append(tpls, t)
+	}
+	return tpls, rows.Err()
+}
+
+func (r *BoardTemplateRepo) GetByID(ctx context.Context, id string) (*domain.BoardTemplate, error) {
+	row := conn(ctx, r.db).QueryRowContext(ctx,
+		"SELECT id, name, description, body, created_at FROM board_templates WHERE id = ?", id,
+	)
+	t, err := scanBoardTemplate(row)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("board template %s: %w", id, domain.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query board template: %w", err)
+	}
+	return &t, nil
+}
+
+func (r *BoardTemplateRepo) Create(ctx context.Context, tpl *domain.BoardTemplate) error {
+	body, err := json.Marshal(templateBody{
+		Columns: tpl.Columns, Cards: tpl.Cards,
+		Priorities: tpl.Priorities, Fields: tpl.Fields, Transitions: tpl.Transitions,
+		EstimateScale: tpl.EstimateScale, StartColumn: tpl.StartColumn, DoneColumn: tpl.DoneColumn,
+	})
+	if err != nil {
+		return fmt.Errorf("encode template body: %w", err)
+	}
+	_, err = conn(ctx, r.db).ExecContext(ctx,
+		"INSERT INTO board_templates (id, name, description, body, created_at) VALUES (?, ?, ?, ?, ?)",
+		tpl.ID, tpl.Name, tpl.Description, string(body), formatTime(tpl.CreatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("insert board template: %w", err)
+	}
+	return nil
+}
+
+func (r *BoardTemplateRepo) Delete(ctx context.Context, id string) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM board_templates WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("delete board template: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("board template %s: %w", id, domain.ErrNotFound)
+	}
+	return nil
+}
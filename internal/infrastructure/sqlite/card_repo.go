@@ -3,6 +3,7 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -18,17 +19,49 @@ func NewCardRepo(db *DB) *CardRepo {
 	return &CardRepo{db: db.DB}
 }
 
-// scanCard scans a card row, handling nullable due_date and TEXT→time.Time conversion.
+// cardSelect lists the card columns read by scanCard; queries alias cards as c.
+const cardSelect = `c.id, c.column_id, c.title, COALESCE(c.description, ''), c.priority,
+        c.start_date, c.due_at, c.estimate, c.position, c.archived_at, c.completed_at, c.created_at, c.updated_at,
+        (SELECT COALESCE(group_concat(person_id), '')
+         FROM (SELECT person_id FROM card_assignees WHERE card_id = c.id ORDER BY rowid)),
+        (SELECT COALESCE(json_group_object(field_id, json(value)), '{}')
+         FROM card_field_values WHERE card_id = c.id)`
+
+// assigneeFilter is a condition on cards c for an assignee filter value: "" matches
+// every card, domain.AssigneeNone unassigned cards, anything else that person's cards.
+func assigneeFilter(assigneeID string) (string, []any) {
+	switch assigneeID {
+	case "":
+		return "1", nil
+	case domain.AssigneeNone:
+		return "NOT EXISTS (SELECT 1 FROM card_assignees ca WHERE ca.card_id = c.id)", nil
+	default:
+		return "EXISTS (SELECT 1 FROM card_assignees ca WHERE ca.card_id = c.id AND ca.person_id = ?)", []any{assigneeID}
+	}
+}
+
+// scanCard scans a card row, handling nullable start/due dates and TEXT→time.Time conversion.
 func scanCard(sc interface{ Scan(dest ...any) error }) (domain.Card, error) {
 	var c domain.Card
-	var due sql.NullString
-	var createdAt, updatedAt string
+	var start, due, archived, completed sql.NullString
+	var estimate sql.NullFloat64
+	var createdAt, updatedAt, assignees, fields string
 	if err := sc.Scan(
 		&c.ID, &c.ColumnID, &c.Title, &c.Description, &c.Priority,
-		&due, &c.Position, &createdAt, &updatedAt,
+		&start, &due, &estimate, &c.Position, &archived, &completed, &createdAt, &updatedAt, &assignees, &fields,
 	); err != nil {
 		return c, err
 	}
+	if estimate.Valid {
+		c.Estimate = &estimate.Float64
+	}
+	if err := json.Unmarshal([]byte(fields), &c.CustomFields); err != nil {
+		return c, fmt.Errorf("parse custom fields: %w", err)
+	}
+	c.AssigneeIDs = []string{}
+	if assignees != "" {
+		c.AssigneeIDs = strings.Split(assignees, ",")
+	}
 
 	var err error
 	if c.CreatedAt, err = parseTime(createdAt); err != nil {
@@ -37,21 +70,74 @@ func scanCard(sc interface{ Scan(dest ...any) error }) (domain.Card, error) {
 	if c.UpdatedAt, err = parseTime(updatedAt); err != nil {
 		return c, fmt.Errorf("parse updated_at: %w", err)
 	}
+	if start.Valid {
+		t, allDay, err := domain.ParseCardDate(start.String)
+		if err != nil {
+			return c, fmt.Errorf("parse start_date: %w", err)
+		}
+		c.StartDate, c.AllDay = &t, allDay
+	}
 	if due.Valid {
-		t, err := parseTime(due.String)
+		t, allDay, err := domain.ParseCardDate(due.String)
+		if err != nil {
+			return c, fmt.Errorf("parse due_at: %w", err)
+		}
+		c.DueAt, c.AllDay = &t, allDay
+	}
+	if archived.Valid {
+		t, err := parseTime(archived.String)
+		if err != nil {
+			return c, fmt.Errorf("parse archived_at: %w", err)
+		}
+		c.ArchivedAt = &t
+	}
+	if completed.Valid {
+		t, err := parseTime(completed.String)
 		if err != nil {
-			return c, fmt.Errorf("parse due_date: %w", err)
+			return c, fmt.Errorf("parse completed_at: %w", err)
 		}
-		c.DueDate = &t
+		c.CompletedAt = &t
 	}
 	return c, nil
 }
 
+// syncCompleted stamps completed_at with at on the cards matching where that sit in
+// a done column and have no stamp yet, and clears it on those in any other column.
+// A card moving between done columns keeps its original stamp. Completed cards get
+// no more reminders, so their reminder snoozes are dropped.
+func syncCompleted(ctx context.Context, db *sql.DB, where string, at time.Time, args ...any) error {
+	_, err := conn(ctx, db).ExecContext(ctx,
+		`UPDATE cards SET completed_at = CASE
+		     WHEN (SELECT kind FROM columns WHERE id = cards.column_id) = ? THEN COALESCE(completed_at, ?)
+		 END
+		 WHERE `+where,
+		append([]any{domain.ColumnKindDone, formatTime(at)}, args...)...,
+	)
+	if err != nil {
+		return fmt.Errorf("sync completed_at: %w", err)
+	}
+	_, err = conn(ctx, db).ExecContext(ctx,
+		`DELETE FROM reminder_snoozes
+		 WHERE card_id IN (SELECT id FROM cards WHERE completed_at IS NOT NULL AND (`+where+`))`,
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("clear snoozes of completed cards: %w", err)
+	}
+	return nil
+}
+
+// cardDateArg converts an optional card date to its stored form (NULL when unset).
+func cardDateArg(t *time.Time, allDay bool) any {
+	if t == nil {
+		return nil
+	}
+	return domain.FormatCardDate(*t, allDay)
+}
+
 func (r *CardRepo) GetByColumnID(ctx context.Context, columnID string) ([]domain.Card, error) {
-	rows, err := r.db.QueryContext(ctx,
-		`SELECT id, column_id, title, COALESCE(description, ''), priority,
-		        due_date, position, created_at, updated_at
-		 FROM cards WHERE column_id = ? ORDER BY position ASC`, columnID,
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		"SELECT "+cardSelect+" FROM cards c WHERE c.column_id = ? AND c.archived_at IS NULL ORDER BY c.position ASC", columnID,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("query cards: %w", err)
@@ -69,19 +155,85 @@ func (r *CardRepo) GetByColumnID(ctx context.Context, columnID string) ([]domain
 	return cards, rows.Err()
 }
 
-func (r *CardRepo) Create(ctx context.Context, card *domain.Card) error {
-	var dueStr any
-	if card.DueDate != nil {
-		dueStr = formatTime(*card.DueDate)
-	}
-	_, err := r.db.ExecContext(ctx,
-		`INSERT INTO cards (id, column_id, title, description, priority, due_date, position, created_at, updated_at)
-		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
-		card.ID, card.ColumnID, card.Title, card.Description, card.Priority,
-		dueStr, card.Position, formatTime(card.CreatedAt), formatTime(card.UpdatedAt),
+func (r *CardRepo) GetByID(ctx context.Context, id string) (*domain.Card, error) {
+	row := conn(ctx, r.db).QueryRowContext(ctx,
+		"SELECT "+cardSelect+" FROM cards c WHERE c.id = ?", id,
 	)
+	c, err := scanCard(row)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("card %s: %w", id, domain.ErrNotFound)
+	}
 	if err != nil {
-		return fmt.Errorf("insert card: %w", err)
+		return nil, fmt.Errorf("query card: %w", err)
+	}
+	return &c, nil
+}
+
+// Create inserts card and records its "created" history event.
+func (r *CardRepo) Create(ctx context.Context, card *domain.Card) error {
+	tx := &Transactor{db: r.db}
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		_, err := conn(ctx, r.db).ExecContext(ctx,
+			`INSERT INTO cards (id, column_id, title, description, priority, start_date, due_at, estimate, position,
+			                    created_at, updated_at)
+			 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			card.ID, card.ColumnID, card.Title, card.Description, card.Priority,
+			cardDateArg(card.StartDate, card.AllDay), cardDateArg(card.DueAt, card.AllDay), card.Estimate,
+			card.Position, formatTime(card.CreatedAt), formatTime(card.UpdatedAt),
+		)
+		if err != nil {
+			return fmt.Errorf("insert card: %w", err)
+		}
+		if err := syncCompleted(ctx, r.db, "id = ?", card.CreatedAt, card.ID); err != nil {
+			return err
+		}
+		_, err = conn(ctx, r.db).ExecContext(ctx,
+			`INSERT INTO card_history (card_id, board_id, kind, to_column_id, to_column_title, at)
+			 SELECT ?, board_id, ?, id, title, ? FROM columns WHERE id = ?`,
+			card.ID, domain.CardEventCreated, formatTime(card.CreatedAt), card.ColumnID,
+		)
+		if err != nil {
+			return fmt.Errorf("record created card: %w", err)
+		}
+		if err := r.insertAssignees(ctx, card.ID, card.AssigneeIDs); err != nil {
+			return err
+		}
+		for fieldID, value := range card.CustomFields {
+			if err := setFieldValue(ctx, r.db, card.ID, fieldID, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SetAssignees replaces a card's assignees, keeping the given order.
+func (r *CardRepo) SetAssignees(ctx context.Context, cardID string, personIDs []string) error {
+	tx := &Transactor{db: r.db}
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		res, err := conn(ctx, r.db).ExecContext(ctx,
+			"UPDATE cards SET updated_at = ? WHERE id = ?", formatTime(time.Now().UTC()), cardID,
+		)
+		if err != nil {
+			return fmt.Errorf("touch card: %w", err)
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return fmt.Errorf("card %s: %w", cardID, domain.ErrNotFound)
+		}
+		if _, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM card_assignees WHERE card_id = ?", cardID); err != nil {
+			return fmt.Errorf("clear assignees: %w", err)
+		}
+		return r.insertAssignees(ctx, cardID, personIDs)
+	})
+}
+
+func (r *CardRepo) insertAssignees(ctx context.Context, cardID string, personIDs []string) error {
+	for _, id := range personIDs {
+		if _, err := conn(ctx, r.db).ExecContext(ctx,
+			"INSERT OR IGNORE INTO card_assignees (card_id, person_id) VALUES (?, ?)", cardID, id,
+		); err != nil {
+			return fmt.Errorf("insert assignee: %w", err)
+		}
 	}
 	return nil
 }
@@ -105,19 +257,23 @@ func (r *CardRepo) Update(ctx context.Context, id string, updates domain.CardUpd
 		b.WriteString(", priority = ?")
 		args = append(args, *updates.Priority)
 	}
-	if updates.DueDate != nil {
-		b.WriteString(", due_date = ?")
-		if *updates.DueDate == "" {
-			args = append(args, nil)
-		} else {
-			args = append(args, *updates.DueDate)
-		}
+	if updates.StartDate != nil {
+		b.WriteString(", start_date = ?")
+		args = append(args, nullIfEmpty(*updates.StartDate))
+	}
+	if updates.DueAt != nil {
+		b.WriteString(", due_at = ?")
+		args = append(args, nullIfEmpty(*updates.DueAt))
+	}
+	if updates.Estimate != nil {
+		b.WriteString(", estimate = CAST(? AS REAL)")
+		args = append(args, nullIfEmpty(*updates.Estimate))
 	}
 
 	args = append(args, id)
 	query := fmt.Sprintf("UPDATE cards SET %s WHERE id = ?", b.String())
 
-	res, err := r.db.ExecContext(ctx, query, args...)
+	res, err := conn(ctx, r.db).ExecContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("update card: %w", err)
 	}
@@ -125,58 +281,173 @@ func (r *CardRepo) Update(ctx context.Context, id string, updates domain.CardUpd
 		return nil, fmt.Errorf("card %s: %w", id, domain.ErrNotFound)
 	}
 
-	row := r.db.QueryRowContext(ctx,
-		`SELECT id, column_id, title, COALESCE(description, ''), priority,
-		        due_date, position, created_at, updated_at
-		 FROM cards WHERE id = ?`, id,
-	)
-	c, err := scanCard(row)
+	c, err := r.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("read updated card: %w", err)
 	}
-	return &c, nil
+	return c, nil
 }
 
+// Delete removes a card and records its "deleted" history event.
 func (r *CardRepo) Delete(ctx context.Context, id string) error {
-	res, err := r.db.ExecContext(ctx, "DELETE FROM cards WHERE id = ?", id)
-	if err != nil {
-		return fmt.Errorf("delete card: %w", err)
-	}
-	if n, _ := res.RowsAffected(); n == 0 {
-		return fmt.Errorf("card %s: %w", id, domain.ErrNotFound)
-	}
-	return nil
+	tx := &Transactor{db: r.db}
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := recordCardsLeaving(ctx, r.db, domain.CardEventDeleted, "c.id = ?", time.Now().UTC(), id); err != nil {
+			return err
+		}
+		res, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM cards WHERE id = ?", id)
+		if err != nil {
+			return fmt.Errorf("delete card: %w", err)
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return fmt.Errorf("card %s: %w", id, domain.ErrNotFound)
+		}
+		return nil
+	})
 }
 
+// Move places a card at newPosition in targetColumnID, stamping or clearing
+// completed_at by the target column's kind.
 func (r *CardRepo) Move(ctx context.Context, id, targetColumnID string, newPosition int) error {
-	now := formatTime(time.Now().UTC())
-	res, err := r.db.ExecContext(ctx,
-		"UPDATE cards SET column_id = ?, position = ?, updated_at = ? WHERE id = ?",
-		targetColumnID, newPosition, now, id,
-	)
-	if err != nil {
-		return fmt.Errorf("move card: %w", err)
-	}
-	if n, _ := res.RowsAffected(); n == 0 {
-		return fmt.Errorf("card %s: %w", id, domain.ErrNotFound)
-	}
-	return nil
+	tx := &Transactor{db: r.db}
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		now := time.Now().UTC()
+		res, err := conn(ctx, r.db).ExecContext(ctx,
+			"UPDATE cards SET column_id = ?, position = ?, updated_at = ? WHERE id = ?",
+			targetColumnID, newPosition, formatTime(now), id,
+		)
+		if err != nil {
+			return fmt.Errorf("move card: %w", err)
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return fmt.Errorf("card %s: %w", id, domain.ErrNotFound)
+		}
+		return syncCompleted(ctx, r.db, "id = ?", now, id)
+	})
 }
 
 func (r *CardRepo) MoveAllToColumn(ctx context.Context, fromColumnID, toColumnID string) error {
-	_, err := r.db.ExecContext(ctx,
-		"UPDATE cards SET column_id = ? WHERE column_id = ?",
-		toColumnID, fromColumnID,
+	tx := &Transactor{db: r.db}
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		_, err := conn(ctx, r.db).ExecContext(ctx,
+			"UPDATE cards SET column_id = ? WHERE column_id = ?",
+			toColumnID, fromColumnID,
+		)
+		if err != nil {
+			return fmt.Errorf("move cards between columns: %w", err)
+		}
+		return syncCompleted(ctx, r.db, "column_id = ?", time.Now().UTC(), toColumnID)
+	})
+}
+
+// SyncCompleted stamps completed_at with at on the cards of a done column that lack
+// one, and clears it on the cards of any other column.
+func (r *CardRepo) SyncCompleted(ctx context.Context, columnID string, at time.Time) error {
+	return syncCompleted(ctx, r.db, "column_id = ?", at, columnID)
+}
+
+func (r *CardRepo) GetByBoardID(ctx context.Context, boardID string) ([]domain.Card, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		`SELECT `+cardSelect+`
+		 FROM cards c
+		 JOIN columns col ON c.column_id = col.id
+		 WHERE col.board_id = ?
+		 ORDER BY col.position ASC, c.position ASC`,
+		boardID,
 	)
 	if err != nil {
-		return fmt.Errorf("move cards between columns: %w", err)
+		return nil, fmt.Errorf("query board cards: %w", err)
 	}
-	return nil
+	defer rows.Close()
+
+	var cards []domain.Card
+	for rows.Next() {
+		c, err := scanCard(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan card: %w", err)
+		}
+		cards = append(cards, c)
+	}
+	return cards, rows.Err()
+}
+
+// Archive sets archived_at once and records the "archived" history event.
+// Archiving an already archived card is a no-op.
+func (r *CardRepo) Archive(ctx context.Context, id string, at time.Time) error {
+	tx := &Transactor{db: r.db}
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := recordCardsLeaving(ctx, r.db, domain.CardEventArchived, "c.id = ? AND c.archived_at IS NULL", at, id); err != nil {
+			return err
+		}
+		res, err := conn(ctx, r.db).ExecContext(ctx,
+			"UPDATE cards SET archived_at = COALESCE(archived_at, ?), updated_at = ? WHERE id = ?",
+			formatTime(at), formatTime(at), id,
+		)
+		if err != nil {
+			return fmt.Errorf("archive card: %w", err)
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return fmt.Errorf("card %s: %w", id, domain.ErrNotFound)
+		}
+		return nil
+	})
+}
+
+// Restore upserts every stored column of card and replaces its assignees and field values.
+func (r *CardRepo) Restore(ctx context.Context, card *domain.Card) error {
+	tx := &Transactor{db: r.db}
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		_, err := conn(ctx, r.db).ExecContext(ctx,
+			`INSERT INTO cards (id, column_id, title, description, priority, start_date, due_at, estimate, position,
+			                    archived_at, completed_at, created_at, updated_at)
+			 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			 ON CONFLICT (id) DO UPDATE SET
+			     column_id = excluded.column_id, title = excluded.title, description = excluded.description,
+			     priority = excluded.priority, start_date = excluded.start_date, due_at = excluded.due_at,
+			     estimate = excluded.estimate, position = excluded.position, archived_at = excluded.archived_at,
+			     completed_at = excluded.completed_at, updated_at = excluded.updated_at`,
+			card.ID, card.ColumnID, card.Title, card.Description, card.Priority,
+			cardDateArg(card.StartDate, card.AllDay), cardDateArg(card.DueAt, card.AllDay), card.Estimate,
+			card.Position, nullableTime(card.ArchivedAt), nullableTime(card.CompletedAt),
+			formatTime(card.CreatedAt), formatTime(time.Now().UTC()),
+		)
+		if err != nil {
+			return fmt.Errorf("restore card: %w", err)
+		}
+
+		if _, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM card_assignees WHERE card_id = ?", card.ID); err != nil {
+			return fmt.Errorf("clear assignees: %w", err)
+		}
+		for _, id := range card.AssigneeIDs {
+			if _, err := conn(ctx, r.db).ExecContext(ctx,
+				"INSERT OR IGNORE INTO card_assignees (card_id, person_id) SELECT ?, id FROM people WHERE id = ?", card.ID, id,
+			); err != nil {
+				return fmt.Errorf("restore assignee: %w", err)
+			}
+		}
+
+		if _, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM card_field_values WHERE card_id = ?", card.ID); err != nil {
+			return fmt.Errorf("clear field values: %w", err)
+		}
+		for fieldID, value := range card.CustomFields {
+			raw, err := json.Marshal(value)
+			if err != nil {
+				return fmt.Errorf("encode field value: %w", err)
+			}
+			if _, err := conn(ctx, r.db).ExecContext(ctx,
+				"INSERT INTO card_field_values (card_id, field_id, value) SELECT ?, id, ? FROM custom_fields WHERE id = ?",
+				card.ID, string(raw), fieldID,
+			); err != nil {
+				return fmt.Errorf("restore field value: %w", err)
+			}
+		}
+		return nil
+	})
 }
 
 func (r *CardRepo) MaxPosition(ctx context.Context, columnID string) (int, error) {
 	var maxPos sql.NullInt64
-	err := r.db.QueryRowContext(ctx,
+	err := conn(ctx, r.db).QueryRowContext(ctx,
 		"SELECT MAX(position) FROM cards WHERE column_id = ?", columnID,
 	).Scan(&maxPos)
 	if err != nil {
@@ -188,16 +459,18 @@ func (r *CardRepo) MaxPosition(ctx context.Context, columnID string) (int, error
 	return int(maxPos.Int64), nil
 }
 
-func (r *CardRepo) Search(ctx context.Context, boardID, query string) ([]domain.Card, error) {
+func (r *CardRepo) Search(ctx context.Context, boardID, query, assigneeID string) ([]domain.Card, error) {
 	pattern := "%" + query + "%"
-	rows, err := r.db.QueryContext(ctx,
-		`SELECT c.id, c.column_id, c.title, COALESCE(c.description, ''), c.priority,
-		        c.due_date, c.position, c.created_at, c.updated_at
+	assigned, args := assigneeFilter(assigneeID)
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		`SELECT `+cardSelect+`
 		 FROM cards c
 		 JOIN columns col ON c.column_id = col.id
-		 WHERE col.board_id = ? AND (c.title LIKE ? OR c.description LIKE ?)
-		 ORDER BY c.position ASC`,
-		boardID, pattern, pattern,
+		 LEFT JOIN board_priorities bp ON bp.board_id = col.board_id AND bp.key = c.priority
+		 WHERE col.board_id = ? AND c.archived_at IS NULL AND (c.title LIKE ? OR c.description LIKE ?)
+		   AND `+assigned+`
+		 ORDER BY COALESCE(bp.weight, 0) DESC, c.position ASC`,
+		append([]any{boardID, pattern, pattern}, args...)...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("search cards: %w", err)
@@ -214,3 +487,11 @@ func (r *CardRepo) Search(ctx context.Context, boardID, query string) ([]domain.
 	}
 	return cards, rows.Err()
 }
+
+// nullIfEmpty maps an empty string to SQL NULL.
+func nullIfEmpty(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
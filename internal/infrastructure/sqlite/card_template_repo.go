@@ -0,0 +1,148 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"kanban-app-playground/internal/domain"
+)
+
+type CardTemplateRepo struct {
+	db *sql.DB
+}
+
+func NewCardTemplateRepo(db *DB) *CardTemplateRepo {
+	return &CardTemplateRepo{db: db.DB}
+}
+
+const cardTemplateSelect = `id, board_id, name, title_pattern, description, priority, labels, checklist, counter, created_at`
+
+func scanCardTemplate(sc interface{ Scan(dest ...any) error }) (domain.CardTemplate, error) {
+	var t domain.CardTemplate
+	var labels, checklist, createdAt string
+	if err := sc.Scan(
+		&t.ID, &t.BoardID, &t.Name, &t.TitlePattern, &t.Description, &t.Priority, &labels, &checklist, &t.Counter, &createdAt,
+	); err != nil {
+		return t, err
+	}
+	if err := json.Unmarshal([]byte(labels), &t.Labels); err != nil {
+		return t, fmt.Errorf("parse labels: %w", err)
+	}
+	if err := json.Unmarshal([]byte(checklist), &t.Checklist); err != nil {
+		return t, fmt.Errorf("parse checklist: %w", err)
+	}
+	var err error
+	if t.CreatedAt, err = parseTime(createdAt); err != nil {
+		return t, fmt.Errorf("parse created_at: %w", err)
+	}
+	return t, nil
+}
+
+func (r *CardTemplateRepo) GetByBoardID(ctx context.Context, boardID string) ([]domain.CardTemplate, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		"SELECT "+cardTemplateSelect+" FROM card_templates WHERE board_id = ? ORDER BY name ASC", boardID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query card templates: %w", err)
+	}
+	defer rows.Close()
+
+	var tpls []domain.CardTemplate
+	for rows.Next() {
+		t, err := scanCardTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan card template: %w", err)
+		}
+		tpls = append(tpls, t)
+	}
+	return tpls, rows.Err()
+}
+
+func (r *CardTemplateRepo) GetByID(ctx context.Context, id string) (*domain.CardTemplate, error) {
+	row := conn(ctx, r.db).QueryRowContext(ctx,
+		"SELECT "+cardTemplateSelect+" FROM card_templates WHERE id = ?", id,
+	)
+	t, err := scanCardTemplate(row)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("card template %s: %w", id, domain.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query card template: %w", err)
+	}
+	return &t, nil
+}
+
+// encodeCardTemplateLists encodes a template's labels and checklist for storage;
+// nil slices are stored as empty arrays.
+func encodeCardTemplateLists(tpl *domain.CardTemplate) (labels, checklist []byte, err error) {
+	if labels, err = json.Marshal(append([]string{}, tpl.Labels...)); err != nil {
+		return nil, nil, fmt.Errorf("encode labels: %w", err)
+	}
+	if checklist, err = json.Marshal(append([]string{}, tpl.Checklist...)); err != nil {
+		return nil, nil, fmt.Errorf("encode checklist: %w", err)
+	}
+	return labels, checklist, nil
+}
+
+func (r *CardTemplateRepo) Create(ctx context.Context, tpl *domain.CardTemplate) error {
+	labels, checklist, err := encodeCardTemplateLists(tpl)
+	if err != nil {
+		return err
+	}
+	_, err = conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO card_templates (id, board_id, name, title_pattern, description, priority, labels, checklist, counter, created_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		tpl.ID, tpl.BoardID, tpl.Name, tpl.TitlePattern, tpl.Description, tpl.Priority, string(labels), string(checklist),
+		tpl.Counter, formatTime(tpl.CreatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("insert card template: %w", err)
+	}
+	return nil
+}
+
+func (r *CardTemplateRepo) Update(ctx context.Context, tpl *domain.CardTemplate) error {
+	labels, checklist, err := encodeCardTemplateLists(tpl)
+	if err != nil {
+		return err
+	}
+	res, err := conn(ctx, r.db).ExecContext(ctx,
+		`UPDATE card_templates SET name = ?, title_pattern = ?, description = ?, priority = ?, labels = ?, checklist = ?
+		 WHERE id = ?`,
+		tpl.Name, tpl.TitlePattern, tpl.Description, tpl.Priority, string(labels), string(checklist), tpl.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("update card template: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("card template %s: %w", tpl.ID, domain.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *CardTemplateRepo) Delete(ctx context.Context, id string) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM card_templates WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("delete card template: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("card template %s: %w", id, domain.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *CardTemplateRepo) NextCounter(ctx context.Context, id string) (int, error) {
+	var counter int
+	err := conn(ctx, r.db).QueryRowContext(ctx,
+		"UPDATE card_templates SET counter = counter + 1 WHERE id = ? RETURNING counter", id,
+	).Scan(&counter)
+	if err == sql.ErrNoRows {
+		return 0, fmt.Errorf("card template %s: %w", id, domain.ErrNotFound)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("increment card template counter: %w", err)
+	}
+	return counter, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"kanban-app-playground/internal/domain"
 )
@@ -19,7 +20,7 @@ func NewColumnRepo(db *DB) *ColumnRepo {
 func scanColumn(sc interface{ Scan(dest ...any) error }) (domain.Column, error) {
 	var c domain.Column
 	var createdAt string
-	if err := sc.Scan(&c.ID, &c.BoardID, &c.Title, &c.Position, &createdAt); err != nil {
+	if err := sc.Scan(&c.ID, &c.BoardID, &c.Title, &c.Kind, &c.Position, &createdAt); err != nil {
 		return c, err
 	}
 	var err error
@@ -30,8 +31,8 @@ func scanColumn(sc interface{ Scan(dest ...any) error }) (domain.Column, error)
 }
 
 func (r *ColumnRepo) GetByBoardID(ctx context.Context, boardID string) ([]domain.Column, error) {
-	rows, err := r.db.QueryContext(ctx,
-		"SELECT id, board_id, title, position, created_at FROM columns WHERE board_id = ? ORDER BY position ASC",
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		"SELECT id, board_id, title, kind, position, created_at FROM columns WHERE board_id = ? ORDER BY position ASC",
 		boardID,
 	)
 	if err != nil {
@@ -51,8 +52,8 @@ func (r *ColumnRepo) GetByBoardID(ctx context.Context, boardID string) ([]domain
 }
 
 func (r *ColumnRepo) GetByID(ctx context.Context, id string) (*domain.Column, error) {
-	row := r.db.QueryRowContext(ctx,
-		"SELECT id, board_id, title, position, created_at FROM columns WHERE id = ?", id,
+	row := conn(ctx, r.db).QueryRowContext(ctx,
+		"SELECT id, board_id, title, kind, position, created_at FROM columns WHERE id = ?", id,
 	)
 	c, err := scanColumn(row)
 	if err == sql.ErrNoRows {
@@ -65,9 +66,9 @@ func (r *ColumnRepo) GetByID(ctx context.Context, id string) (*domain.Column, er
 }
 
 func (r *ColumnRepo) Create(ctx context.Context, col *domain.Column) error {
-	_, err := r.db.ExecContext(ctx,
-		"INSERT INTO columns (id, board_id, title, position, created_at) VALUES (?, ?, ?, ?, ?)",
-		col.ID, col.BoardID, col.Title, col.Position, formatTime(col.CreatedAt),
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		"INSERT INTO columns (id, board_id, title, kind, position, created_at) VALUES (?, ?, ?, ?, ?, ?)",
+		col.ID, col.BoardID, col.Title, col.Kind, col.Position, formatTime(col.CreatedAt),
 	)
 	if err != nil {
 		return fmt.Errorf("insert column: %w", err)
@@ -76,25 +77,19 @@ func (r *ColumnRepo) Create(ctx context.Context, col *domain.Column) error {
 }
 
 func (r *ColumnRepo) CreateBatch(ctx context.Context, cols []domain.Column) error {
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("begin tx: %w", err)
-	}
-	defer tx.Rollback()
-
-	for i := range cols {
-		if _, err := tx.ExecContext(ctx,
-			"INSERT INTO columns (id, board_id, title, position, created_at) VALUES (?, ?, ?, ?, ?)",
-			cols[i].ID, cols[i].BoardID, cols[i].Title, cols[i].Position, formatTime(cols[i].CreatedAt),
-		); err != nil {
-			return fmt.Errorf("insert column %d: %w", i, err)
+	tx := &Transactor{db: r.db}
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		for i := range cols {
+			if err := r.Create(ctx, &cols[i]); err != nil {
+				return fmt.Errorf("insert column %d: %w", i, err)
+			}
 		}
-	}
-	return tx.Commit()
+		return nil
+	})
 }
 
 func (r *ColumnRepo) Update(ctx context.Context, col *domain.Column) error {
-	res, err := r.db.ExecContext(ctx,
+	res, err := conn(ctx, r.db).ExecContext(ctx,
 		"UPDATE columns SET title = ? WHERE id = ?",
 		col.Title, col.ID,
 	)
@@ -107,20 +102,28 @@ func (r *ColumnRepo) Update(ctx context.Context, col *domain.Column) error {
 	return nil
 }
 
+// Delete removes a column, recording a "deleted" history event for each card
+// that is removed along with it.
 func (r *ColumnRepo) Delete(ctx context.Context, id string) error {
-	res, err := r.db.ExecContext(ctx, "DELETE FROM columns WHERE id = ?", id)
-	if err != nil {
-		return fmt.Errorf("delete column: %w", err)
-	}
-	if n, _ := res.RowsAffected(); n == 0 {
-		return fmt.Errorf("column %s: %w", id, domain.ErrNotFound)
-	}
-	return nil
+	tx := &Transactor{db: r.db}
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		if err := recordCardsLeaving(ctx, r.db, domain.CardEventDeleted, "c.column_id = ?", time.Now().UTC(), id); err != nil {
+			return err
+		}
+		res, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM columns WHERE id = ?", id)
+		if err != nil {
+			return fmt.Errorf("delete column: %w", err)
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return fmt.Errorf("column %s: %w", id, domain.ErrNotFound)
+		}
+		return nil
+	})
 }
 
 func (r *ColumnRepo) CountByBoardID(ctx context.Context, boardID string) (int, error) {
 	var count int
-	err := r.db.QueryRowContext(ctx,
+	err := conn(ctx, r.db).QueryRowContext(ctx,
 		"SELECT COUNT(*) FROM columns WHERE board_id = ?", boardID,
 	).Scan(&count)
 	if err != nil {
@@ -131,7 +134,7 @@ func (r *ColumnRepo) CountByBoardID(ctx context.Context, boardID string) (int, e
 
 func (r *ColumnRepo) MaxPosition(ctx context.Context, boardID string) (int, error) {
 	var maxPos sql.NullInt64
-	err := r.db.QueryRowContext(ctx,
+	err := conn(ctx, r.db).QueryRowContext(ctx,
 		"SELECT MAX(position) FROM columns WHERE board_id = ?", boardID,
 	).Scan(&maxPos)
 	if err != nil {
@@ -144,7 +147,7 @@ func (r *ColumnRepo) MaxPosition(ctx context.Context, boardID string) (int, erro
 }
 
 func (r *ColumnRepo) UpdatePosition(ctx context.Context, id string, position int) error {
-	res, err := r.db.ExecContext(ctx,
+	res, err := conn(ctx, r.db).ExecContext(ctx,
 		"UPDATE columns SET position = ? WHERE id = ?", position, id,
 	)
 	if err != nil {
@@ -155,3 +158,16 @@ func (r *ColumnRepo) UpdatePosition(ctx context.Context, id string, position int
 	}
 	return nil
 }
+
+// SetKind changes a column's kind. Cards in the column keep their completed_at until
+// CardRepo.SyncCompleted brings them in line.
+func (r *ColumnRepo) SetKind(ctx context.Context, id, kind string) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx, "UPDATE columns SET kind = ? WHERE id = ?", kind, id)
+	if err != nil {
+		return fmt.Errorf("set column kind: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("column %s: %w", id, domain.ErrNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"kanban-app-playground/internal/domain"
+)
+
+const customFieldSelect = `id, board_id, name, type, options, position, created_at`
+
+type CustomFieldRepo struct {
+	db *sql.DB
+}
+
+func NewCustomFieldRepo(db *DB) *CustomFieldRepo {
+	return &CustomFieldRepo{db: db.DB}
+}
+
+func scanCustomField(sc interface{ Scan(dest ...any) error }) (domain.CustomField, error) {
+	var f domain.CustomField
+	var options, createdAt string
+	if err := sc.Scan(&f.ID, &f.BoardID, &f.Name, &f.Type, &options, &f.Position, &createdAt); err != nil {
+		return f, err
+	}
+	if err := json.Unmarshal([]byte(options), &f.Options); err != nil {
+		return f, fmt.Errorf("parse options: %w", err)
+	}
+	if f.Options == nil {
+		f.Options = []string{}
+	}
+	var err error
+	if f.CreatedAt, err = parseTime(createdAt); err != nil {
+		return f, fmt.Errorf("parse created_at: %w", err)
+	}
+	return f, nil
+}
+
+func (r *CustomFieldRepo) GetByBoardID(ctx context.Context, boardID string) ([]domain.CustomField, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		"SELECT "+customFieldSelect+" FROM custom_fields WHERE board_id = ? ORDER BY position ASC", boardID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query custom fields: %w", err)
+	}
+	defer rows.Close()
+
+	var fields []domain.CustomField
+	for rows.Next() {
+		f, err := scanCustomField(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan custom field: %w", err)
+		}
+		fields = append(fields, f)
+	}
+	return fields, rows.Err()
+}
+
+func (r *CustomFieldRepo) GetByID(ctx context.Context, id string) (*domain.CustomField, error) {
+	row := conn(ctx, r.db).QueryRowContext(ctx,
+		"SELECT "+customFieldSelect+" FROM custom_fields WHERE id = ?", id,
+	)
+	f, err := scanCustomField(row)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("custom field %s: %w", id, domain.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query custom field: %w", err)
+	}
+	return &f, nil
+}
+
+func (r *CustomFieldRepo) Create(ctx context.Context, f *domain.CustomField) error {
+	options, err := json.Marshal(f.Options)
+	if err != nil {
+		return fmt.Errorf("encode options: %w", err)
+	}
+	_, err = conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO custom_fields (id, board_id, name, type, options, position, created_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		f.ID, f.BoardID, f.Name, f.Type, string(options), f.Position, formatTime(f.CreatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("insert custom field: %w", err)
+	}
+	return nil
+}
+
+func (r *CustomFieldRepo) Update(ctx context.Context, f *domain.CustomField) error {
+	options, err := json.Marshal(f.Options)
+	if err != nil {
+		return fmt.Errorf("encode options: %w", err)
+	}
+	res, err := conn(ctx, r.db).ExecContext(ctx,
+		"UPDATE custom_fields SET name = ?, options = ?, position = ? WHERE id = ?",
+		f.Name, string(options), f.Position, f.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("update custom field: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("custom field %s: %w", f.ID, domain.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *CustomFieldRepo) Delete(ctx context.Context, id string) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM custom_fields WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("delete custom field: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("custom field %s: %w", id, domain.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *CustomFieldRepo) SetValue(ctx context.Context, cardID, fieldID string, value any) error {
+	return setFieldValue(ctx, r.db, cardID, fieldID, value)
+}
+
+func (r *CustomFieldRepo) Values(ctx context.Context, fieldID string) (map[string]any, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		"SELECT card_id, value FROM card_field_values WHERE field_id = ?", fieldID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query field values: %w", err)
+	}
+	defer rows.Close()
+
+	values := make(map[string]any)
+	for rows.Next() {
+		var cardID, raw string
+		if err := rows.Scan(&cardID, &raw); err != nil {
+			return nil, fmt.Errorf("scan field value: %w", err)
+		}
+		var v any
+		if err := json.Unmarshal([]byte(raw), &v); err != nil {
+			return nil, fmt.Errorf("parse field value: %w", err)
+		}
+		values[cardID] = v
+	}
+	return values, rows.Err()
+}
+
+// setFieldValue stores value as JSON for a card and field, or removes it when nil.
+func setFieldValue(ctx context.Context, db *sql.DB, cardID, fieldID string, value any) error {
+	if value == nil {
+		_, err := conn(ctx, db).ExecContext(ctx,
+			"DELETE FROM card_field_values WHERE card_id = ? AND field_id = ?", cardID, fieldID,
+		)
+		if err != nil {
+			return fmt.Errorf("clear field value: %w", err)
+		}
+		return nil
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("encode field value: %w", err)
+	}
+	_, err = conn(ctx, db).ExecContext(ctx,
+		`INSERT INTO card_field_values (card_id, field_id, value) VALUES (?, ?, ?)
+		 ON CONFLICT (card_id, field_id) DO UPDATE SET value = excluded.value`,
+		cardID, fieldID, string(raw),
+	)
+	if err != nil {
+		return fmt.Errorf("set field value: %w", err)
+	}
+	return nil
+}
@@ -14,7 +14,7 @@ type DB struct {
 	*sql.DB
 }
 
-// NewDB opens (or creates) the SQLite database with WAL mode enabled.
+// NewDB opens the database in the user config directory:
 //   - macOS: ~/Library/Application Support/KanbanApp/data.db
 //   - Windows: %AppData%/KanbanApp/data.db
 //   - Linux: ~/.config/KanbanApp/data.db
@@ -29,12 +29,28 @@ func NewDB() (*DB, error) {
 		return nil, fmt.Errorf("create db dir: %w", err)
 	}
 
-	dbPath := filepath.Join(dbDir, "data.db")
-	db, err := sql.Open("sqlite", dbPath)
+	return OpenDB(filepath.Join(dbDir, "data.db"))
+}
+
+// OpenDB opens (or creates) the SQLite database at path with WAL mode enabled
+// and runs migrations.
+func OpenDB(path string) (*DB, error) {
+	db, err := sql.Open("sqlite", path)
 	if err != nil {
 		return nil, fmt.Errorf("open database: %w", err)
 	}
 
+	// A single connection keeps per-connection PRAGMAs (foreign_keys) in effect for
+	// every query and lets ChangeWatcher tell our own commits from other processes'.
+	db.SetMaxOpenConns(1)
+
+	// Another process (e.g. a CLI) may hold the write lock briefly; wait for it
+	// instead of failing with SQLITE_BUSY.
+	if _, err := db.Exec("PRAGMA busy_timeout=5000"); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("set busy timeout: %w", err)
+	}
+
 	if _, err := db.Exec("PRAGMA journal_mode=WAL"); err != nil {
 		db.Close()
 		return nil, fmt.Errorf("enable WAL: %w", err)
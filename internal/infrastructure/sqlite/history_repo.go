@@ -0,0 +1,88 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+const historySelect = `id, card_id, board_id, kind, COALESCE(from_board_id, ''), COALESCE(from_column_id, ''),
+        COALESCE(to_column_id, ''), COALESCE(to_column_title, ''), at`
+
+type HistoryRepo struct {
+	db *sql.DB
+}
+
+func NewHistoryRepo(db *DB) *HistoryRepo {
+	return &HistoryRepo{db: db.DB}
+}
+
+// Record appends e, snapshotting the target column's current title.
+func (r *HistoryRepo) Record(ctx context.Context, e *domain.CardEvent) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO card_history (card_id, board_id, kind, from_board_id, from_column_id, to_column_id, to_column_title, at)
+		 VALUES (?, ?, ?, ?, ?, ?, (SELECT title FROM columns WHERE id = ?), ?)`,
+		e.CardID, e.BoardID, e.Kind, nullIfEmpty(e.FromBoardID), nullIfEmpty(e.FromColumnID),
+		nullIfEmpty(e.ToColumnID), e.ToColumnID, formatTime(e.At),
+	)
+	if err != nil {
+		return fmt.Errorf("insert card event: %w", err)
+	}
+	e.ID, err = res.LastInsertId()
+	return err
+}
+
+func (r *HistoryRepo) ListByCard(ctx context.Context, cardID string) ([]domain.CardEvent, error) {
+	return r.list(ctx,
+		"SELECT "+historySelect+" FROM card_history WHERE card_id = ? ORDER BY at, id", cardID)
+}
+
+func (r *HistoryRepo) ListByBoard(ctx context.Context, boardID string, until time.Time) ([]domain.CardEvent, error) {
+	return r.list(ctx,
+		`SELECT `+historySelect+` FROM card_history
+		 WHERE (board_id = ? OR from_board_id = ?) AND at <= ?
+		 ORDER BY at, id`,
+		boardID, boardID, formatTime(until.UTC()))
+}
+
+func (r *HistoryRepo) list(ctx context.Context, query string, args ...any) ([]domain.CardEvent, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query card history: %w", err)
+	}
+	defer rows.Close()
+
+	var events []domain.CardEvent
+	for rows.Next() {
+		var e domain.CardEvent
+		var at string
+		if err := rows.Scan(&e.ID, &e.CardID, &e.BoardID, &e.Kind, &e.FromBoardID, &e.FromColumnID,
+			&e.ToColumnID, &e.ToColumnTitle, &at); err != nil {
+			return nil, fmt.Errorf("scan card event: %w", err)
+		}
+		if e.At, err = parseTime(at); err != nil {
+			return nil, fmt.Errorf("parse at: %w", err)
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+// recordCardsLeaving appends an event of kind for every card matching where
+// (a condition on cards c), capturing the column and board each card is leaving.
+func recordCardsLeaving(ctx context.Context, db *sql.DB, kind, where string, at time.Time, args ...any) error {
+	_, err := conn(ctx, db).ExecContext(ctx,
+		`INSERT INTO card_history (card_id, board_id, kind, from_column_id, at)
+		 SELECT c.id, col.board_id, ?, c.column_id, ?
+		 FROM cards c JOIN columns col ON c.column_id = col.id
+		 WHERE `+where,
+		append([]any{kind, formatTime(at)}, args...)...,
+	)
+	if err != nil {
+		return fmt.Errorf("record %s cards: %w", kind, err)
+	}
+	return nil
+}
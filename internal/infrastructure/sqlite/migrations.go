@@ -1,6 +1,11 @@
 package sqlite
 
-import "database/sql"
+import (
+	"database/sql"
+	"fmt"
+
+	"kanban-app-playground/internal/domain"
+)
 
 func runMigrations(db *sql.DB) error {
 	schema := `
@@ -34,6 +39,535 @@ CREATE TABLE IF NOT EXISTS cards (
 CREATE INDEX IF NOT EXISTS idx_columns_board_id ON columns(board_id);
 CREATE INDEX IF NOT EXISTS idx_cards_column_id ON cards(column_id);
 `
-	_, err := db.Exec(schema)
+	if _, err := db.Exec(schema); err != nil {
+		return err
+	}
+	return upgradeSchema(db)
+}
+
+// schemaUpgrades evolve the base schema above. Each runs once, in order, inside
+// its own transaction; PRAGMA user_version records how many have been applied.
+// Append new upgrades to the end — never reorder or edit applied ones.
+var schemaUpgrades = []func(tx *sql.Tx) error{
+	upgradeCardSchedule,
+	upgradeBoardTemplates,
+	upgradeCardTemplates,
+	upgradeRecurrences,
+	upgradeCardHistory,
+	upgradeCardArchive,
+	upgradeCardLifecycleHistory,
+	upgradeBoardFlowColumns,
+	upgradePeople,
+	upgradeEstimates,
+	upgradeTimeEntries,
+	upgradeCustomFields,
+	upgradePriorities,
+	upgradeColumnKinds,
+	upgradeWorkflows,
+	upgradeAutomations,
+	upgradeUndoEntries,
+	upgradeCardTemplateLabels,
+	upgradeReminders,
+}
+
+func upgradeSchema(db *sql.DB) error {
+	var version int
+	if err := db.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		return fmt.Errorf("read user_version: %w", err)
+	}
+
+	if version >= len(schemaUpgrades) {
+		return nil
+	}
+
+	// Foreign keys are off while upgrading so an upgrade can rebuild a table without
+	// cascading deletes (https://sqlite.org/lang_altertable.html#otheralter);
+	// foreign_key_check verifies each upgrade before it commits.
+	if _, err := db.Exec("PRAGMA foreign_keys=OFF"); err != nil {
+		return fmt.Errorf("disable foreign keys: %w", err)
+	}
+	defer db.Exec("PRAGMA foreign_keys=ON")
+
+	for i := version; i < len(schemaUpgrades); i++ {
+		tx, err := db.Begin()
+		if err != nil {
+			return fmt.Errorf("begin upgrade %d: %w", i+1, err)
+		}
+		if err := schemaUpgrades[i](tx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("upgrade %d: %w", i+1, err)
+		}
+		if err := checkForeignKeys(tx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("upgrade %d: %w", i+1, err)
+		}
+		// PRAGMA does not accept bound parameters.
+		if _, err := tx.Exec(fmt.Sprintf("PRAGMA user_version = %d", i+1)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("set user_version %d: %w", i+1, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("commit upgrade %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// checkForeignKeys fails if any row references a missing parent.
+func checkForeignKeys(tx *sql.Tx) error {
+	var table, parent string
+	var rowid sql.NullInt64
+	var fkid int
+	err := tx.QueryRow("PRAGMA foreign_key_check").Scan(&table, &rowid, &parent, &fkid)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("check foreign keys: %w", err)
+	}
+	return fmt.Errorf("foreign key violation: %s references missing %s", table, parent)
+}
+
+// upgradeCardSchedule renames due_date to due_at, adds start_date and rewrites
+// existing due dates into canonical form: all-day "YYYY-MM-DD" or RFC3339 UTC.
+func upgradeCardSchedule(tx *sql.Tx) error {
+	if _, err := tx.Exec("ALTER TABLE cards RENAME COLUMN due_date TO due_at"); err != nil {
+		return fmt.Errorf("rename due_date: %w", err)
+	}
+	if _, err := tx.Exec("ALTER TABLE cards ADD COLUMN start_date TEXT"); err != nil {
+		return fmt.Errorf("add start_date: %w", err)
+	}
+
+	rows, err := tx.Query("SELECT id, due_at FROM cards WHERE due_at IS NOT NULL")
+	if err != nil {
+		return fmt.Errorf("query due dates: %w", err)
+	}
+	normalized := make(map[string]any)
+	for rows.Next() {
+		var id, raw string
+		if err := rows.Scan(&id, &raw); err != nil {
+			rows.Close()
+			return fmt.Errorf("scan due date: %w", err)
+		}
+		normalized[id] = normalizeLegacyDueDate(raw)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for id, due := range normalized {
+		if _, err := tx.Exec("UPDATE cards SET due_at = ? WHERE id = ?", due, id); err != nil {
+			return fmt.Errorf("normalize due date for card %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// normalizeLegacyDueDate converts a free-form due_date written before dates were
+// validated. Values that cannot be parsed are dropped (NULL).
+func normalizeLegacyDueDate(raw string) any {
+	if t, allDay, err := domain.ParseCardDate(raw); err == nil {
+		return domain.FormatCardDate(t, allDay)
+	}
+	if t, err := parseTime(raw); err == nil {
+		return domain.FormatCardDate(t, false)
+	}
+	return nil
+}
+
+func upgradeBoardTemplates(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+CREATE TABLE board_templates (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    created_at TEXT NOT NULL DEFAULT (datetime('now'))
+)`)
+	return err
+}
+
+func upgradeCardTemplates(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+CREATE TABLE card_templates (
+    id TEXT PRIMARY KEY,
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    title_pattern TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    priority TEXT NOT NULL DEFAULT 'medium',
+    counter INTEGER NOT NULL DEFAULT 0,
+    created_at TEXT NOT NULL DEFAULT (datetime('now'))
+);
+CREATE INDEX idx_card_templates_board_id ON card_templates(board_id);`)
+	return err
+}
+
+// upgradeRecurrences adds recurrences. last_card_id deliberately has no foreign key:
+// the previous instance being deleted counts as it being completed.
+func upgradeRecurrences(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+CREATE TABLE recurrences (
+    id TEXT PRIMARY KEY,
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    column_id TEXT NOT NULL REFERENCES columns(id) ON DELETE CASCADE,
+    template_id TEXT REFERENCES card_templates(id) ON DELETE CASCADE,
+    title TEXT NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    priority TEXT NOT NULL DEFAULT 'medium',
+    rule TEXT NOT NULL,
+    start_at TEXT NOT NULL,
+    mode TEXT NOT NULL CHECK(mode IN ('schedule', 'on_completion')),
+    catch_up TEXT NOT NULL CHECK(catch_up IN ('latest', 'all')),
+    next_run_at TEXT,
+    last_card_id TEXT,
+    occurrences INTEGER NOT NULL DEFAULT 0,
+    created_at TEXT NOT NULL DEFAULT (datetime('now'))
+);
+CREATE INDEX idx_recurrences_board_id ON recurrences(board_id);`)
+	return err
+}
+
+// upgradeCardHistory adds card_history. card_id deliberately has no foreign key so
+// history outlives the card it describes.
+func upgradeCardHistory(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+CREATE TABLE card_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    card_id TEXT NOT NULL,
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    from_board_id TEXT,
+    from_column_id TEXT,
+    to_column_id TEXT,
+    at TEXT NOT NULL
+);
+CREATE INDEX idx_card_history_card_id ON card_history(card_id);
+CREATE INDEX idx_card_history_board_id ON card_history(board_id, at);`)
+	return err
+}
+
+func upgradeCardArchive(tx *sql.Tx) error {
+	_, err := tx.Exec(`ALTER TABLE cards ADD COLUMN archived_at TEXT`)
+	return err
+}
+
+// upgradeCardLifecycleHistory snapshots column titles in card_history and backfills
+// "created" and "archived" events for cards that predate them. A card's original
+// column is taken from its first recorded move, or its current column if it never moved.
+func upgradeCardLifecycleHistory(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+ALTER TABLE card_history ADD COLUMN to_column_title TEXT;
+
+UPDATE card_history SET to_column_title = (SELECT title FROM columns WHERE id = card_history.to_column_id);
+
+INSERT INTO card_history (card_id, board_id, kind, to_column_id, to_column_title, at)
+SELECT c.id,
+       COALESCE(first.from_board_id, first.board_id, col.board_id),
+       'created',
+       COALESCE(first.from_column_id, c.column_id),
+       (SELECT title FROM columns WHERE id = COALESCE(first.from_column_id, c.column_id)),
+       strftime('%Y-%m-%dT%H:%M:%SZ', c.created_at)
+FROM cards c
+JOIN columns col ON c.column_id = col.id
+LEFT JOIN card_history first ON first.id = (
+    SELECT h.id FROM card_history h WHERE h.card_id = c.id ORDER BY h.at, h.id LIMIT 1
+);
+
+INSERT INTO card_history (card_id, board_id, kind, from_column_id, at)
+SELECT c.id, col.board_id, 'archived', c.column_id, c.archived_at
+FROM cards c
+JOIN columns col ON c.column_id = col.id
+WHERE c.archived_at IS NOT NULL;`)
+	return err
+}
+
+func upgradeBoardFlowColumns(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+ALTER TABLE boards ADD COLUMN start_column_id TEXT REFERENCES columns(id) ON DELETE SET NULL;
+ALTER TABLE boards ADD COLUMN done_column_id TEXT REFERENCES columns(id) ON DELETE SET NULL;`)
+	return err
+}
+
+func upgradePeople(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+CREATE TABLE people (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    initials TEXT NOT NULL DEFAULT '',
+    color TEXT NOT NULL DEFAULT '',
+    email TEXT NOT NULL DEFAULT '',
+    created_at TEXT NOT NULL DEFAULT (datetime('now'))
+);
+CREATE TABLE card_assignees (
+    card_id TEXT NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    person_id TEXT NOT NULL REFERENCES people(id) ON DELETE CASCADE,
+    PRIMARY KEY (card_id, person_id)
+);
+CREATE INDEX idx_card_assignees_person_id ON card_assignees(person_id);`)
+	return err
+}
+
+func upgradeEstimates(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+ALTER TABLE cards ADD COLUMN estimate REAL;
+ALTER TABLE boards ADD COLUMN estimate_scale TEXT NOT NULL DEFAULT 'fibonacci';`)
+	return err
+}
+
+// upgradeTimeEntries adds time tracking. The partial unique index allows at most
+// one running timer (ended_at IS NULL) across the whole database.
+func upgradeTimeEntries(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+CREATE TABLE time_entries (
+    id TEXT PRIMARY KEY,
+    card_id TEXT NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    started_at TEXT NOT NULL,
+    ended_at TEXT,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TEXT NOT NULL
+);
+CREATE INDEX idx_time_entries_card_id ON time_entries(card_id);
+CREATE INDEX idx_time_entries_started_at ON time_entries(started_at);
+CREATE UNIQUE INDEX idx_time_entries_running ON time_entries((ended_at IS NULL)) WHERE ended_at IS NULL;`)
+	return err
+}
+
+// upgradeCustomFields adds board-level custom fields. Values are stored as JSON.
+func upgradeCustomFields(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+CREATE TABLE custom_fields (
+    id TEXT PRIMARY KEY,
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    type TEXT NOT NULL,
+    options TEXT NOT NULL DEFAULT '[]',
+    position INTEGER NOT NULL,
+    created_at TEXT NOT NULL
+);
+CREATE INDEX idx_custom_fields_board_id ON custom_fields(board_id);
+CREATE TABLE card_field_values (
+    card_id TEXT NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    field_id TEXT NOT NULL REFERENCES custom_fields(id) ON DELETE CASCADE,
+    value TEXT NOT NULL,
+    PRIMARY KEY (card_id, field_id)
+);
+CREATE INDEX idx_card_field_values_field_id ON card_field_values(field_id);`)
+	return err
+}
+
+// upgradePriorities moves priorities from a fixed CHECK constraint to a per-board
+// scheme. Every existing board gets the default levels, which include low, medium
+// and high, so existing cards keep their priority; legacy NULLs become medium.
+// cards is rebuilt because SQLite cannot drop a CHECK constraint in place.
+func upgradePriorities(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+CREATE TABLE board_priorities (
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    key TEXT NOT NULL,
+    name TEXT NOT NULL,
+    color TEXT NOT NULL,
+    weight INTEGER NOT NULL,
+    PRIMARY KEY (board_id, key)
+);
+CREATE TABLE cards_new (
+    id TEXT PRIMARY KEY,
+    column_id TEXT NOT NULL REFERENCES columns(id) ON DELETE CASCADE,
+    title TEXT NOT NULL,
+    description TEXT,
+    priority TEXT NOT NULL DEFAULT 'medium',
+    due_at TEXT,
+    position INTEGER NOT NULL,
+    created_at TEXT NOT NULL DEFAULT (datetime('now')),
+    updated_at TEXT NOT NULL DEFAULT (datetime('now')),
+    start_date TEXT,
+    archived_at TEXT,
+    estimate REAL
+);
+INSERT INTO cards_new (id, column_id, title, description, priority, due_at, position, created_at, updated_at,
+                       start_date, archived_at, estimate)
+SELECT id, column_id, title, description, COALESCE(priority, 'medium'), due_at, position, created_at, updated_at,
+       start_date, archived_at, estimate
+FROM cards;
+DROP TABLE cards;
+ALTER TABLE cards_new RENAME TO cards;
+CREATE INDEX idx_cards_column_id ON cards(column_id);`)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range domain.DefaultPriorities() {
+		if _, err := tx.Exec(
+			"INSERT INTO board_priorities (board_id, key, name, color, weight) SELECT id, ?, ?, ?, ? FROM boards",
+			l.Key, l.Name, l.Color, l.Weight,
+		); err != nil {
+			return fmt.Errorf("seed priorities: %w", err)
+		}
+	}
+	return nil
+}
+
+// upgradeColumnKinds adds column kinds and card completion stamps. Existing columns
+// are classified from their board's flow columns (see Board.InferColumnKinds), and
+// cards already in a done column are stamped with when they last entered it.
+func upgradeColumnKinds(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+ALTER TABLE columns ADD COLUMN kind TEXT NOT NULL DEFAULT 'active';
+ALTER TABLE cards ADD COLUMN completed_at TEXT;`)
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.Query("SELECT id, COALESCE(start_column_id, ''), COALESCE(done_column_id, '') FROM boards")
+	if err != nil {
+		return fmt.Errorf("query boards: %w", err)
+	}
+	var boards []domain.Board
+	for rows.Next() {
+		var b domain.Board
+		if err := rows.Scan(&b.ID, &b.StartColumnID, &b.DoneColumnID); err != nil {
+			rows.Close()
+			return fmt.Errorf("scan board: %w", err)
+		}
+		boards = append(boards, b)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, b := range boards {
+		rows, err := tx.Query("SELECT id, position FROM columns WHERE board_id = ? ORDER BY position ASC", b.ID)
+		if err != nil {
+			return fmt.Errorf("query columns: %w", err)
+		}
+		var cols []domain.Column
+		for rows.Next() {
+			var c domain.Column
+			if err := rows.Scan(&c.ID, &c.Position); err != nil {
+				rows.Close()
+				return fmt.Errorf("scan column: %w", err)
+			}
+			cols = append(cols, c)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		b.InferColumnKinds(cols)
+		for _, c := range cols {
+			if _, err := tx.Exec("UPDATE columns SET kind = ? WHERE id = ?", c.Kind, c.ID); err != nil {
+				return fmt.Errorf("set kind of column %s: %w", c.ID, err)
+			}
+		}
+	}
+
+	_, err = tx.Exec(`
+UPDATE cards SET completed_at = COALESCE(
+    (SELECT MAX(h.at) FROM card_history h WHERE h.card_id = cards.id AND h.to_column_id = cards.column_id),
+    updated_at
+)
+WHERE column_id IN (SELECT id FROM columns WHERE kind = ?)`, domain.ColumnKindDone)
+	if err != nil {
+		return fmt.Errorf("stamp completed cards: %w", err)
+	}
+	return nil
+}
+
+func upgradeWorkflows(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+CREATE TABLE column_transitions (
+    from_column_id TEXT NOT NULL REFERENCES columns(id) ON DELETE CASCADE,
+    to_column_id TEXT NOT NULL REFERENCES columns(id) ON DELETE CASCADE,
+    PRIMARY KEY (from_column_id, to_column_id)
+);
+CREATE INDEX idx_column_transitions_to_column_id ON column_transitions(to_column_id);`)
+	return err
+}
+
+func upgradeAutomations(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+CREATE TABLE automation_rules (
+    id TEXT PRIMARY KEY,
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    enabled INTEGER NOT NULL DEFAULT 1,
+    trigger_kind TEXT NOT NULL,
+    trigger_column_id TEXT NOT NULL DEFAULT '',
+    conditions TEXT NOT NULL DEFAULT '[]',
+    actions TEXT NOT NULL DEFAULT '[]',
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL
+);
+CREATE INDEX idx_automation_rules_board_id ON automation_rules(board_id);
+CREATE INDEX idx_automation_rules_trigger_kind ON automation_rules(trigger_kind);
+
+CREATE TABLE automation_runs (
+    id TEXT PRIMARY KEY,
+    rule_id TEXT NOT NULL REFERENCES automation_rules(id) ON DELETE CASCADE,
+    board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    card_id TEXT NOT NULL,
+    trigger_kind TEXT NOT NULL,
+    status TEXT NOT NULL,
+    message TEXT NOT NULL DEFAULT '',
+    ran_at TEXT NOT NULL
+);
+CREATE INDEX idx_automation_runs_board_id ON automation_runs(board_id, ran_at);
+CREATE INDEX idx_automation_runs_rule_id ON automation_runs(rule_id);
+
+CREATE TABLE automation_due_fired (
+    rule_id TEXT NOT NULL REFERENCES automation_rules(id) ON DELETE CASCADE,
+    card_id TEXT NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    due_date TEXT NOT NULL,
+    PRIMARY KEY (rule_id, card_id)
+);
+CREATE INDEX idx_automation_due_fired_card_id ON automation_due_fired(card_id);`)
+	return err
+}
+
+func upgradeUndoEntries(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+CREATE TABLE undo_entries (
+    id TEXT PRIMARY KEY,
+    kind TEXT NOT NULL,
+    cards TEXT NOT NULL DEFAULT '[]',
+    created_at TEXT NOT NULL
+);
+CREATE INDEX idx_undo_entries_created_at ON undo_entries(created_at);`)
+	return err
+}
+
+// upgradeCardTemplateLabels adds labels and checklist items to card templates, both
+// stored as JSON arrays of strings.
+func upgradeCardTemplateLabels(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+ALTER TABLE card_templates ADD COLUMN labels TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE card_templates ADD COLUMN checklist TEXT NOT NULL DEFAULT '[]';`)
+	return err
+}
+
+// upgradeReminders adds app settings and reminder state. Databases created while
+// these tables were part of the base schema already have them, hence IF NOT EXISTS.
+func upgradeReminders(tx *sql.Tx) error {
+	_, err := tx.Exec(`
+CREATE TABLE IF NOT EXISTS settings (
+    key TEXT PRIMARY KEY,
+    value TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS reminders_sent (
+    card_id TEXT NOT NULL REFERENCES cards(id) ON DELETE CASCADE,
+    reminder_key TEXT NOT NULL,
+    due_date TEXT NOT NULL,
+    sent_at TEXT NOT NULL,
+    PRIMARY KEY (card_id, reminder_key)
+);
+
+CREATE TABLE IF NOT EXISTS reminder_snoozes (
+    card_id TEXT PRIMARY KEY REFERENCES cards(id) ON DELETE CASCADE,
+    snoozed_until TEXT NOT NULL
+);`)
 	return err
 }
@@ -0,0 +1,482 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// legacyDB returns a database with the base schema and every schema upgrade before
+// upgrade applied, as an install from before that upgrade would have it.
+func legacyDB(t *testing.T, upgrade func(*sql.Tx) error) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "data.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	n := -1
+	for i, u := range schemaUpgrades {
+		if reflect.ValueOf(u).Pointer() == reflect.ValueOf(upgrade).Pointer() {
+			n = i
+		}
+	}
+	if n < 0 {
+		t.Fatal("upgrade is not in schemaUpgrades")
+	}
+
+	// With every upgrade marked as applied, runMigrations creates only the base schema.
+	if _, err := db.Exec(fmt.Sprintf("PRAGMA user_version = %d", len(schemaUpgrades))); err != nil {
+		t.Fatal(err)
+	}
+	if err := runMigrations(db); err != nil {
+		t.Fatal(err)
+	}
+	for i := range n {
+		tx, err := db.Begin()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := schemaUpgrades[i](tx); err != nil {
+			t.Fatalf("upgrade %d: %v", i+1, err)
+		}
+		if err := tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := db.Exec(fmt.Sprintf("PRAGMA user_version = %d", n)); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestUpgradeCardSchedule(t *testing.T) {
+	tests := []struct {
+		legacy string
+		want   sql.NullString
+	}{
+		{"2026-03-05", sql.NullString{String: "2026-03-05", Valid: true}},
+		{"2024-02-29", sql.NullString{String: "2024-02-29", Valid: true}},
+		{"2026-03-05T10:30:00Z", sql.NullString{String: "2026-03-05T10:30:00Z", Valid: true}},
+		{"2026-03-05T10:30:00+08:00", sql.NullString{String: "2026-03-05T02:30:00Z", Valid: true}},
+		{"2026-03-05T23:30:00-05:00", sql.NullString{String: "2026-03-06T04:30:00Z", Valid: true}},
+		{"2026-03-05 10:30:00", sql.NullString{String: "2026-03-05T10:30:00Z", Valid: true}},
+		{"next friday", sql.NullString{}},
+		{"2026-02-30", sql.NullString{}},
+		{"05/03/2026", sql.NullString{}},
+		{"", sql.NullString{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.legacy, func(t *testing.T) {
+			db := legacyDB(t, upgradeCardSchedule)
+			for _, stmt := range []string{
+				`INSERT INTO boards (id, title) VALUES ('b1', 'Board')`,
+				`INSERT INTO columns (id, board_id, title, position) VALUES ('todo', 'b1', 'To do', 0)`,
+			} {
+				if _, err := db.Exec(stmt); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if _, err := db.Exec(
+				`INSERT INTO cards (id, column_id, title, position, due_date) VALUES ('c1', 'todo', 'Card', 0, ?)`, tt.legacy,
+			); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := upgradeSchema(db); err != nil {
+				t.Fatal(err)
+			}
+
+			var got sql.NullString
+			if err := db.QueryRow("SELECT due_at FROM cards WHERE id = 'c1'").Scan(&got); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("due_at = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpgradeCardLifecycleHistory(t *testing.T) {
+	type event struct {
+		Kind, BoardID, From, To, ToTitle, At string
+	}
+	created := func(boardID, columnID, title string) event {
+		return event{"created", boardID, "", columnID, title, "2025-01-02T03:04:05Z"}
+	}
+	tests := []struct {
+		name string
+		seed []string
+		want []event
+	}{
+		{
+			name: "never moved",
+			seed: []string{`INSERT INTO cards (id, column_id, title, position, created_at) VALUES ('c1', 'doing', 'Card', 0, '2025-01-02 03:04:05')`},
+			want: []event{created("b1", "doing", "Doing")},
+		},
+		{
+			name: "moved within the board",
+			seed: []string{
+				`INSERT INTO cards (id, column_id, title, position, created_at) VALUES ('c1', 'done', 'Card', 0, '2025-01-02 03:04:05')`,
+				`INSERT INTO card_history (card_id, board_id, kind, from_column_id, to_column_id, at)
+				 VALUES ('c1', 'b1', 'moved', 'todo', 'doing', '2025-01-03T00:00:00Z'),
+				        ('c1', 'b1', 'moved', 'doing', 'done', '2025-01-04T00:00:00Z')`,
+			},
+			want: []event{
+				created("b1", "todo", "To do"),
+				{"moved", "b1", "todo", "doing", "Doing", "2025-01-03T00:00:00Z"},
+				{"moved", "b1", "doing", "done", "Done", "2025-01-04T00:00:00Z"},
+			},
+		},
+		{
+			name: "moved from another board",
+			seed: []string{
+				`INSERT INTO cards (id, column_id, title, position, created_at) VALUES ('c1', 'todo', 'Card', 0, '2025-01-02 03:04:05')`,
+				`INSERT INTO card_history (card_id, board_id, kind, from_board_id, from_column_id, to_column_id, at)
+				 VALUES ('c1', 'b1', 'moved', 'b2', 'inbox', 'todo', '2025-01-03T00:00:00Z')`,
+			},
+			want: []event{
+				created("b2", "inbox", "Inbox"),
+				{"moved", "b1", "inbox", "todo", "To do", "2025-01-03T00:00:00Z"},
+			},
+		},
+		{
+			name: "first column since deleted",
+			seed: []string{
+				`INSERT INTO cards (id, column_id, title, position, created_at) VALUES ('c1', 'todo', 'Card', 0, '2025-01-02 03:04:05')`,
+				`INSERT INTO card_history (card_id, board_id, kind, from_column_id, to_column_id, at)
+				 VALUES ('c1', 'b1', 'moved', 'gone', 'todo', '2025-01-03T00:00:00Z')`,
+			},
+			want: []event{
+				created("b1", "gone", ""),
+				{"moved", "b1", "gone", "todo", "To do", "2025-01-03T00:00:00Z"},
+			},
+		},
+		{
+			name: "archived",
+			seed: []string{`INSERT INTO cards (id, column_id, title, position, created_at, archived_at)
+			 VALUES ('c1', 'done', 'Card', 0, '2025-01-02 03:04:05', '2025-01-05T00:00:00Z')`},
+			want: []event{
+				created("b1", "done", "Done"),
+				{"archived", "b1", "done", "", "", "2025-01-05T00:00:00Z"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := legacyDB(t, upgradeCardLifecycleHistory)
+			seed := append([]string{
+				`INSERT INTO boards (id, title) VALUES ('b1', 'Board'), ('b2', 'Other')`,
+				`INSERT INTO columns (id, board_id, title, position)
+				 VALUES ('todo', 'b1', 'To do', 0), ('doing', 'b1', 'Doing', 1), ('done', 'b1', 'Done', 2), ('inbox', 'b2', 'Inbox', 0)`,
+			}, tt.seed...)
+			for _, stmt := range seed {
+				if _, err := db.Exec(stmt); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := upgradeSchema(db); err != nil {
+				t.Fatal(err)
+			}
+
+			rows, err := db.Query(`
+SELECT kind, board_id, COALESCE(from_column_id, ''), COALESCE(to_column_id, ''), COALESCE(to_column_title, ''), at
+FROM card_history WHERE card_id = 'c1' ORDER BY at, id`)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rows.Close()
+			var got []event
+			for rows.Next() {
+				var e event
+				if err := rows.Scan(&e.Kind, &e.BoardID, &e.From, &e.To, &e.ToTitle, &e.At); err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, e)
+			}
+			if err := rows.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("history =\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpgradePriorities(t *testing.T) {
+	db := legacyDB(t, upgradePriorities)
+	for _, stmt := range []string{
+		`INSERT INTO boards (id, title) VALUES ('b1', 'Board'), ('b2', 'Other')`,
+		`INSERT INTO columns (id, board_id, title, position) VALUES ('todo', 'b1', 'To do', 0), ('inbox', 'b2', 'Inbox', 0)`,
+		`INSERT INTO cards (id, column_id, title, description, priority, due_at, start_date, position, created_at, updated_at,
+		                    archived_at, estimate)
+		 VALUES ('c1', 'todo', 'High', 'Details', 'high', '2025-01-10', '2025-01-05', 1000, '2025-01-01 00:00:00',
+		         '2025-01-02 00:00:00', NULL, 3),
+		        ('c2', 'todo', 'Low', NULL, 'low', NULL, NULL, 2000, '2025-01-01 00:00:00', '2025-01-01 00:00:00',
+		         '2025-01-03T00:00:00Z', NULL),
+		        ('c3', 'inbox', 'Unset', NULL, NULL, NULL, NULL, 1000, '2025-01-01 00:00:00', '2025-01-01 00:00:00', NULL, NULL)`,
+		`INSERT INTO people (id, name) VALUES ('p1', 'Ada')`,
+		`INSERT INTO card_assignees (card_id, person_id) VALUES ('c1', 'p1')`,
+		`INSERT INTO custom_fields (id, board_id, name, type, position, created_at)
+		 VALUES ('f1', 'b1', 'Size', 'text', 0, '2025-01-01T00:00:00Z')`,
+		`INSERT INTO card_field_values (card_id, field_id, value) VALUES ('c1', 'f1', '"L"')`,
+		`INSERT INTO time_entries (id, card_id, started_at, ended_at, created_at)
+		 VALUES ('t1', 'c1', '2025-01-02T00:00:00Z', '2025-01-02T01:00:00Z', '2025-01-02T00:00:00Z')`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := upgradeSchema(db); err != nil {
+		t.Fatal(err)
+	}
+
+	type card struct {
+		ID, Title, Priority, Position, CreatedAt, UpdatedAt string
+		Description, DueAt, StartDate, ArchivedAt           sql.NullString
+		Estimate                                            sql.NullFloat64
+	}
+	rows, err := db.Query(`
+SELECT id, title, priority, position, created_at, updated_at, description, due_at, start_date, archived_at, estimate
+FROM cards ORDER BY id`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var got []card
+	for rows.Next() {
+		var c card
+		if err := rows.Scan(&c.ID, &c.Title, &c.Priority, &c.Position, &c.CreatedAt, &c.UpdatedAt,
+			&c.Description, &c.DueAt, &c.StartDate, &c.ArchivedAt, &c.Estimate); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, c)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	str := func(s string) sql.NullString { return sql.NullString{String: s, Valid: true} }
+	want := []card{
+		{ID: "c1", Title: "High", Priority: "high", Position: "1000", CreatedAt: "2025-01-01 00:00:00",
+			UpdatedAt: "2025-01-02 00:00:00", Description: str("Details"), DueAt: str("2025-01-10"),
+			StartDate: str("2025-01-05"), Estimate: sql.NullFloat64{Float64: 3, Valid: true}},
+		{ID: "c2", Title: "Low", Priority: "low", Position: "2000", CreatedAt: "2025-01-01 00:00:00",
+			UpdatedAt: "2025-01-01 00:00:00", ArchivedAt: str("2025-01-03T00:00:00Z")},
+		{ID: "c3", Title: "Unset", Priority: "medium", Position: "1000", CreatedAt: "2025-01-01 00:00:00",
+			UpdatedAt: "2025-01-01 00:00:00"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("cards =\n%+v\nwant\n%+v", got, want)
+	}
+
+	var schema string
+	if err := db.QueryRow("SELECT sql FROM sqlite_master WHERE type = 'table' AND name = 'cards'").Scan(&schema); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(strings.ToUpper(schema), "CHECK") {
+		t.Errorf("cards still has a CHECK constraint:\n%s", schema)
+	}
+	if _, err := db.Exec(`INSERT INTO cards (id, column_id, title, priority, position) VALUES ('c4', 'todo', 'Custom', 'critical', 3000)`); err != nil {
+		t.Errorf("insert with a non-legacy priority: %v", err)
+	}
+
+	var index string
+	if err := db.QueryRow("SELECT name FROM sqlite_master WHERE type = 'index' AND tbl_name = 'cards' AND name = 'idx_cards_column_id'").Scan(&index); err != nil {
+		t.Errorf("idx_cards_column_id: %v", err)
+	}
+
+	for _, board := range []string{"b1", "b2"} {
+		var keys []string
+		rows, err := db.Query("SELECT key FROM board_priorities WHERE board_id = ? ORDER BY weight", board)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for rows.Next() {
+			var key string
+			if err := rows.Scan(&key); err != nil {
+				t.Fatal(err)
+			}
+			keys = append(keys, key)
+		}
+		rows.Close()
+		var want []string
+		for _, l := range domain.DefaultPriorities() {
+			want = append(want, l.Key)
+		}
+		if !reflect.DeepEqual(keys, want) {
+			t.Errorf("priorities of %s = %v, want %v", board, keys, want)
+		}
+	}
+
+	for table, query := range map[string]string{
+		"card_assignees":    "SELECT COUNT(*) FROM card_assignees WHERE card_id = 'c1'",
+		"card_field_values": "SELECT COUNT(*) FROM card_field_values WHERE card_id = 'c1'",
+		"time_entries":      "SELECT COUNT(*) FROM time_entries WHERE card_id = 'c1'",
+	} {
+		var n int
+		if err := db.QueryRow(query).Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		if n != 1 {
+			t.Errorf("%s rows of c1 = %d, want 1", table, n)
+		}
+	}
+	fk, err := db.Query("PRAGMA foreign_key_check")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fk.Close()
+	if fk.Next() {
+		var table, parent string
+		var rowid sql.NullInt64
+		var fkid int
+		if err := fk.Scan(&table, &rowid, &parent, &fkid); err != nil {
+			t.Fatal(err)
+		}
+		t.Errorf("foreign key violation: %s references missing %s", table, parent)
+	}
+}
+
+func TestUpgradeColumnKinds(t *testing.T) {
+	tests := []struct {
+		name      string
+		flow      string // UPDATE boards SET ... for b1, if any
+		kinds     map[string]string
+		completed map[string]string
+	}{
+		{
+			name:      "default flow columns",
+			kinds:     map[string]string{"idea": "backlog", "todo": "active", "doing": "active", "done": "done"},
+			completed: map[string]string{"c1": "", "c2": "2025-01-04T00:00:00Z", "c3": "2025-01-09 00:00:00"},
+		},
+		{
+			name:      "explicit flow columns",
+			flow:      `UPDATE boards SET start_column_id = 'idea', done_column_id = 'doing' WHERE id = 'b1'`,
+			kinds:     map[string]string{"idea": "active", "todo": "active", "doing": "done", "done": "done"},
+			completed: map[string]string{"c1": "2025-01-08 00:00:00", "c2": "2025-01-04T00:00:00Z", "c3": "2025-01-09 00:00:00"},
+		},
+		{
+			name:      "start column only",
+			flow:      `UPDATE boards SET start_column_id = 'doing' WHERE id = 'b1'`,
+			kinds:     map[string]string{"idea": "backlog", "todo": "backlog", "doing": "active", "done": "done"},
+			completed: map[string]string{"c1": "", "c2": "2025-01-04T00:00:00Z", "c3": "2025-01-09 00:00:00"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := legacyDB(t, upgradeColumnKinds)
+			seed := []string{
+				`INSERT INTO boards (id, title) VALUES ('b1', 'Board'), ('b2', 'Single')`,
+				`INSERT INTO columns (id, board_id, title, position)
+				 VALUES ('idea', 'b1', 'Ideas', 0), ('todo', 'b1', 'To do', 1), ('doing', 'b1', 'Doing', 2),
+				        ('done', 'b1', 'Done', 3), ('only', 'b2', 'Only', 0)`,
+				`INSERT INTO cards (id, column_id, title, position, created_at, updated_at)
+				 VALUES ('c1', 'doing', 'In progress', 0, '2025-01-01 00:00:00', '2025-01-08 00:00:00'),
+				        ('c2', 'done', 'Moved to done', 0, '2025-01-01 00:00:00', '2025-01-10 00:00:00'),
+				        ('c3', 'done', 'Created in done', 1, '2025-01-01 00:00:00', '2025-01-09 00:00:00')`,
+				`INSERT INTO card_history (card_id, board_id, kind, from_column_id, to_column_id, at)
+				 VALUES ('c2', 'b1', 'moved', 'todo', 'done', '2025-01-02T00:00:00Z'),
+				        ('c2', 'b1', 'moved', 'done', 'doing', '2025-01-03T00:00:00Z'),
+				        ('c2', 'b1', 'moved', 'doing', 'done', '2025-01-04T00:00:00Z')`,
+			}
+			if tt.flow != "" {
+				seed = append(seed, tt.flow)
+			}
+			for _, stmt := range seed {
+				if _, err := db.Exec(stmt); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := upgradeSchema(db); err != nil {
+				t.Fatal(err)
+			}
+
+			kinds := map[string]string{}
+			rows, err := db.Query("SELECT id, kind FROM columns WHERE board_id = 'b1'")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rows.Close()
+			for rows.Next() {
+				var id, kind string
+				if err := rows.Scan(&id, &kind); err != nil {
+					t.Fatal(err)
+				}
+				kinds[id] = kind
+			}
+			if err := rows.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(kinds, tt.kinds) {
+				t.Errorf("kinds = %v, want %v", kinds, tt.kinds)
+			}
+
+			var only string
+			if err := db.QueryRow("SELECT kind FROM columns WHERE id = 'only'").Scan(&only); err != nil {
+				t.Fatal(err)
+			}
+			if only != "active" {
+				t.Errorf("a board's only column is %s, want active", only)
+			}
+
+			completed := map[string]string{}
+			rows, err = db.Query("SELECT id, COALESCE(completed_at, '') FROM cards")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer rows.Close()
+			for rows.Next() {
+				var id, at string
+				if err := rows.Scan(&id, &at); err != nil {
+					t.Fatal(err)
+				}
+				completed[id] = at
+			}
+			if err := rows.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(completed, tt.completed) {
+				t.Errorf("completed_at = %v, want %v", completed, tt.completed)
+			}
+		})
+	}
+}
+
+func TestUpgradeRemindersKeepsExistingTables(t *testing.T) {
+	db := legacyDB(t, upgradeReminders)
+	// Databases created while the reminder tables were in the base schema already have them.
+	if _, err := db.Exec(`
+CREATE TABLE settings (key TEXT PRIMARY KEY, value TEXT NOT NULL);
+INSERT INTO settings (key, value) VALUES ('reminders', '{"enabled":false}');`); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := upgradeSchema(db); err != nil {
+		t.Fatal(err)
+	}
+
+	var value string
+	if err := db.QueryRow("SELECT value FROM settings WHERE key = 'reminders'").Scan(&value); err != nil {
+		t.Fatal(err)
+	}
+	if value != `{"enabled":false}` {
+		t.Errorf("settings = %s, want the stored value", value)
+	}
+	for _, table := range []string{"reminders_sent", "reminder_snoozes"} {
+		if _, err := db.Exec("SELECT COUNT(*) FROM " + table); err != nil {
+			t.Errorf("%s: %v", table, err)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"kanban-app-playground/internal/domain"
+)
+
+type PersonRepo struct {
+	db *sql.DB
+}
+
+func NewPersonRepo(db *DB) *PersonRepo {
+	return &PersonRepo{db: db.DB}
+}
+
+func scanPerson(sc interface{ Scan(dest ...any) error }) (domain.Person, error) {
+	var p domain.Person
+	var createdAt string
+	if err := sc.Scan(&p.ID, &p.Name, &p.Initials, &p.Color, &p.Email, &createdAt); err != nil {
+		return p, err
+	}
+	var err error
+	if p.CreatedAt, err = parseTime(createdAt); err != nil {
+		return p, fmt.Errorf("parse created_at: %w", err)
+	}
+	return p, nil
+}
+
+func (r *PersonRepo) GetAll(ctx context.Context) ([]domain.Person, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		"SELECT id, name, initials, color, email, created_at FROM people ORDER BY name COLLATE NOCASE ASC",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query people: %w", err)
+	}
+	defer rows.Close()
+
+	var people []domain.Person
+	for rows.Next() {
+		p, err := scanPerson(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan person: %w", err)
+		}
+		people = append(people, p)
+	}
+	return people, rows.Err()
+}
+
+func (r *PersonRepo) GetByID(ctx context.Context, id string) (*domain.Person, error) {
+	row := conn(ctx, r.db).QueryRowContext(ctx,
+		"SELECT id, name, initials, color, email, created_at FROM people WHERE id = ?", id,
+	)
+	p, err := scanPerson(row)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("person %s: %w", id, domain.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query person: %w", err)
+	}
+	return &p, nil
+}
+
+func (r *PersonRepo) Create(ctx context.Context, p *domain.Person) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		"INSERT INTO people (id, name, initials, color, email, created_at) VALUES (?, ?, ?, ?, ?, ?)",
+		p.ID, p.Name, p.Initials, p.Color, p.Email, formatTime(p.CreatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("insert person: %w", err)
+	}
+	return nil
+}
+
+func (r *PersonRepo) Update(ctx context.Context, p *domain.Person) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx,
+		"UPDATE people SET name = ?, initials = ?, color = ?, email = ? WHERE id = ?",
+		p.Name, p.Initials, p.Color, p.Email, p.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("update person: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("person %s: %w", p.ID, domain.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *PersonRepo) Delete(ctx context.Context, id string) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM people WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("delete person: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("person %s: %w", id, domain.ErrNotFound)
+	}
+	return nil
+}
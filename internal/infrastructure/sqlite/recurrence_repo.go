@@ -0,0 +1,127 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"kanban-app-playground/internal/domain"
+)
+
+type RecurrenceRepo struct {
+	db *sql.DB
+}
+
+func NewRecurrenceRepo(db *DB) *RecurrenceRepo {
+	return &RecurrenceRepo{db: db.DB}
+}
+
+const recurrenceSelect = `id, board_id, column_id, COALESCE(template_id, ''), title, description, priority,
+        rule, start_at, mode, catch_up, next_run_at, COALESCE(last_card_id, ''), occurrences, created_at`
+
+func scanRecurrence(sc interface{ Scan(dest ...any) error }) (domain.Recurrence, error) {
+	var r domain.Recurrence
+	var startAt, createdAt string
+	var nextRun sql.NullString
+	if err := sc.Scan(
+		&r.ID, &r.BoardID, &r.ColumnID, &r.TemplateID, &r.Title, &r.Description, &r.Priority,
+		&r.Rule, &startAt, &r.Mode, &r.CatchUp, &nextRun, &r.LastCardID, &r.Occurrences, &createdAt,
+	); err != nil {
+		return r, err
+	}
+	var err error
+	if r.StartAt, r.AllDay, err = domain.ParseCardDate(startAt); err != nil {
+		return r, fmt.Errorf("parse start_at: %w", err)
+	}
+	if r.CreatedAt, err = parseTime(createdAt); err != nil {
+		return r, fmt.Errorf("parse created_at: %w", err)
+	}
+	if nextRun.Valid {
+		t, err := parseTime(nextRun.String)
+		if err != nil {
+			return r, fmt.Errorf("parse next_run_at: %w", err)
+		}
+		r.NextRunAt = &t
+	}
+	return r, nil
+}
+
+func (r *RecurrenceRepo) query(ctx context.Context, where string, args ...any) ([]domain.Recurrence, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		"SELECT "+recurrenceSelect+" FROM recurrences "+where+" ORDER BY created_at ASC", args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query recurrences: %w", err)
+	}
+	defer rows.Close()
+
+	var recs []domain.Recurrence
+	for rows.Next() {
+		rec, err := scanRecurrence(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan recurrence: %w", err)
+		}
+		recs = append(recs, rec)
+	}
+	return recs, rows.Err()
+}
+
+func (r *RecurrenceRepo) GetByBoardID(ctx context.Context, boardID string) ([]domain.Recurrence, error) {
+	return r.query(ctx, "WHERE board_id = ?", boardID)
+}
+
+func (r *RecurrenceRepo) GetActive(ctx context.Context) ([]domain.Recurrence, error) {
+	return r.query(ctx, "WHERE next_run_at IS NOT NULL")
+}
+
+func (r *RecurrenceRepo) GetByID(ctx context.Context, id string) (*domain.Recurrence, error) {
+	row := conn(ctx, r.db).QueryRowContext(ctx, "SELECT "+recurrenceSelect+" FROM recurrences WHERE id = ?", id)
+	rec, err := scanRecurrence(row)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("recurrence %s: %w", id, domain.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query recurrence: %w", err)
+	}
+	return &rec, nil
+}
+
+func (r *RecurrenceRepo) Create(ctx context.Context, rec *domain.Recurrence) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO recurrences (id, board_id, column_id, template_id, title, description, priority,
+		                          rule, start_at, mode, catch_up, next_run_at, last_card_id, occurrences, created_at)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		rec.ID, rec.BoardID, rec.ColumnID, nullIfEmpty(rec.TemplateID), rec.Title, rec.Description, rec.Priority,
+		rec.Rule, domain.FormatCardDate(rec.StartAt, rec.AllDay), rec.Mode, rec.CatchUp, nullableTime(rec.NextRunAt),
+		nullIfEmpty(rec.LastCardID), rec.Occurrences, formatTime(rec.CreatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("insert recurrence: %w", err)
+	}
+	return nil
+}
+
+func (r *RecurrenceRepo) UpdateProgress(ctx context.Context, rec *domain.Recurrence) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx,
+		"UPDATE recurrences SET next_run_at = ?, last_card_id = ?, occurrences = ? WHERE id = ?",
+		nullableTime(rec.NextRunAt), nullIfEmpty(rec.LastCardID), rec.Occurrences, rec.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("update recurrence: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("recurrence %s: %w", rec.ID, domain.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *RecurrenceRepo) Delete(ctx context.Context, id string) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM recurrences WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("delete recurrence: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("recurrence %s: %w", id, domain.ErrNotFound)
+	}
+	return nil
+}
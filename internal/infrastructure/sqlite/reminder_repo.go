@@ -0,0 +1,125 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+const reminderSettingsKey = "reminders"
+
+type ReminderRepo struct {
+	db *sql.DB
+}
+
+func NewReminderRepo(db *DB) *ReminderRepo {
+	return &ReminderRepo{db: db.DB}
+}
+
+func (r *ReminderRepo) GetSettings(ctx context.Context) (domain.ReminderSettings, error) {
+	settings := domain.DefaultReminderSettings()
+	if _, err := getSetting(ctx, r.db, reminderSettingsKey, &settings); err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
+
+func (r *ReminderRepo) SaveSettings(ctx context.Context, settings domain.ReminderSettings) error {
+	return putSetting(ctx, r.db, reminderSettingsKey, settings)
+}
+
+func (r *ReminderRepo) ListCandidates(ctx context.Context) ([]domain.ReminderCandidate, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		`SELECT c.id, c.title, b.id, b.title, c.due_at
+		 FROM cards c
+		 JOIN columns col ON c.column_id = col.id
+		 JOIN boards b ON col.board_id = b.id
+		 WHERE c.due_at IS NOT NULL AND c.archived_at IS NULL AND c.completed_at IS NULL`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query reminder candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var out []domain.ReminderCandidate
+	for rows.Next() {
+		var c domain.ReminderCandidate
+		var due string
+		if err := rows.Scan(&c.CardID, &c.CardTitle, &c.BoardID, &c.BoardTitle, &due); err != nil {
+			return nil, fmt.Errorf("scan reminder candidate: %w", err)
+		}
+		if c.DueAt, c.AllDay, err = domain.ParseCardDate(due); err != nil {
+			return nil, fmt.Errorf("parse due_at: %w", err)
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+func (r *ReminderRepo) WasSent(ctx context.Context, cardID, key string, due time.Time) (bool, error) {
+	var n int
+	err := conn(ctx, r.db).QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM reminders_sent WHERE card_id = ? AND reminder_key = ? AND due_date = ?",
+		cardID, key, formatTime(due),
+	).Scan(&n)
+	if err != nil {
+		return false, fmt.Errorf("query reminders_sent: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (r *ReminderRepo) MarkSent(ctx context.Context, cardID, key string, due, sentAt time.Time) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO reminders_sent (card_id, reminder_key, due_date, sent_at) VALUES (?, ?, ?, ?)
+		 ON CONFLICT(card_id, reminder_key) DO UPDATE SET due_date = excluded.due_date, sent_at = excluded.sent_at`,
+		cardID, key, formatTime(due), formatTime(sentAt),
+	)
+	if err != nil {
+		return fmt.Errorf("insert reminders_sent: %w", err)
+	}
+	return nil
+}
+
+func (r *ReminderRepo) Snooze(ctx context.Context, cardID string, until time.Time) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO reminder_snoozes (card_id, snoozed_until) VALUES (?, ?)
+		 ON CONFLICT(card_id) DO UPDATE SET snoozed_until = excluded.snoozed_until`,
+		cardID, formatTime(until),
+	)
+	if err != nil {
+		return fmt.Errorf("snooze reminder: %w", err)
+	}
+	return nil
+}
+
+func (r *ReminderRepo) Snoozes(ctx context.Context) (map[string]time.Time, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, "SELECT card_id, snoozed_until FROM reminder_snoozes")
+	if err != nil {
+		return nil, fmt.Errorf("query snoozes: %w", err)
+	}
+	defer rows.Close()
+
+	out := make(map[string]time.Time)
+	for rows.Next() {
+		var cardID, until string
+		if err := rows.Scan(&cardID, &until); err != nil {
+			return nil, fmt.Errorf("scan snooze: %w", err)
+		}
+		t, err := parseTime(until)
+		if err != nil {
+			return nil, fmt.Errorf("parse snoozed_until: %w", err)
+		}
+		out[cardID] = t
+	}
+	return out, rows.Err()
+}
+
+func (r *ReminderRepo) ClearSnooze(ctx context.Context, cardID string) error {
+	if _, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM reminder_snoozes WHERE card_id = ?", cardID); err != nil {
+		return fmt.Errorf("clear snooze: %w", err)
+	}
+	return nil
+}
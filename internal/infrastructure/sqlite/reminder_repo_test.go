@@ -0,0 +1,52 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+func TestCompletingACardClearsItsSnooze(t *testing.T) {
+	ctx := context.Background()
+	db, err := OpenDB(filepath.Join(t.TempDir(), "data.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := db.Exec(`
+INSERT INTO boards (id, title) VALUES ('b1', 'Board');
+INSERT INTO columns (id, board_id, title, position, kind)
+VALUES ('todo', 'b1', 'To do', 0, 'active'), ('done', 'b1', 'Done', 1, 'done');`); err != nil {
+		t.Fatal(err)
+	}
+
+	cards, reminders := NewCardRepo(db), NewReminderRepo(db)
+	now := time.Now().UTC()
+	for _, id := range []string{"open", "completed"} {
+		card := &domain.Card{ID: id, ColumnID: "todo", Title: id, Priority: "medium", CreatedAt: now, UpdatedAt: now}
+		if err := cards.Create(ctx, card); err != nil {
+			t.Fatal(err)
+		}
+		if err := reminders.Snooze(ctx, id, now.Add(time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := cards.Move(ctx, "completed", "done", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	snoozes, err := reminders.Snoozes(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := snoozes["completed"]; ok {
+		t.Error("completed card is still snoozed")
+	}
+	if _, ok := snoozes["open"]; !ok {
+		t.Error("open card lost its snooze")
+	}
+}
@@ -0,0 +1,42 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+)
+
+// getSetting decodes the JSON value stored under key into dest.
+// It reports false when the key has never been saved.
+func getSetting(ctx context.Context, db *sql.DB, key string, dest any) (bool, error) {
+	var raw string
+	err := conn(ctx, db).QueryRowContext(ctx, "SELECT value FROM settings WHERE key = ?", key).Scan(&raw)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("query setting %s: %w", key, err)
+	}
+	if err := json.Unmarshal([]byte(raw), dest); err != nil {
+		return false, fmt.Errorf("decode setting %s: %w", key, err)
+	}
+	return true, nil
+}
+
+// putSetting stores value as JSON under key, replacing any previous value.
+func putSetting(ctx context.Context, db *sql.DB, key string, value any) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("encode setting %s: %w", key, err)
+	}
+	_, err = conn(ctx, db).ExecContext(ctx,
+		`INSERT INTO settings (key, value) VALUES (?, ?)
+		 ON CONFLICT(key) DO UPDATE SET value = excluded.value`,
+		key, string(raw),
+	)
+	if err != nil {
+		return fmt.Errorf("save setting %s: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// statsCTE defines, for a board ID, the board's open cards: not archived and not completed.
+const statsCTE = `WITH open AS (
+    SELECT c.id, c.title, c.column_id, c.due_at, c.created_at
+    FROM cards c JOIN columns col ON c.column_id = col.id
+    WHERE col.board_id = ? AND c.archived_at IS NULL AND c.completed_at IS NULL
+)`
+
+// overdueCond is a condition on an open card's due_at taking (today, now): all-day
+// dates are compared as local calendar days, timed ones as UTC instants.
+const overdueCond = `((length(due_at) = 10 AND due_at < ?) OR (length(due_at) > 10 AND due_at < ?))`
+
+type StatsRepo struct {
+	db *sql.DB
+}
+
+func NewStatsRepo(db *DB) *StatsRepo {
+	return &StatsRepo{db: db.DB}
+}
+
+func (r *StatsRepo) BoardStats(ctx context.Context, boardID string, at domain.StatsCutoffs) (*domain.BoardStats, error) {
+	stats := &domain.BoardStats{
+		BoardID:    boardID,
+		ByColumn:   []domain.ColumnCount{},
+		ByAssignee: []domain.AssigneeLoad{},
+		ByPriority: []domain.PriorityCount{},
+	}
+	if err := r.summary(ctx, stats, boardID, at); err != nil {
+		return nil, err
+	}
+	if err := r.byColumn(ctx, stats, boardID); err != nil {
+		return nil, err
+	}
+	if err := r.byPriority(ctx, stats, boardID); err != nil {
+		return nil, err
+	}
+	if err := r.oldestOpen(ctx, stats, boardID); err != nil {
+		return nil, err
+	}
+	if err := r.activity(ctx, stats, boardID, at.Now); err != nil {
+		return nil, err
+	}
+	if err := r.byAssignee(ctx, stats, boardID, at); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// summary fills the card totals and due-date counts. All-day due dates are compared
+// as local calendar days, timed ones as UTC instants.
+func (r *StatsRepo) summary(ctx context.Context, stats *domain.BoardStats, boardID string, at domain.StatsCutoffs) error {
+	today, weekEnd := at.Today.Format(time.DateOnly), at.WeekEnd.Format(time.DateOnly)
+	now, weekEndAt := formatTime(at.Now.UTC()), formatTime(at.WeekEnd.UTC())
+	err := conn(ctx, r.db).QueryRowContext(ctx, statsCTE+`
+		SELECT
+		    (SELECT COUNT(*) FROM cards c JOIN columns col ON c.column_id = col.id
+		     WHERE col.board_id = ? AND c.archived_at IS NULL),
+		    (SELECT COUNT(*) FROM open),
+		    (SELECT COUNT(*) FROM open WHERE due_at IS NULL),
+		    (SELECT COUNT(*) FROM open WHERE `+overdueCond+`),
+		    (SELECT COUNT(*) FROM open
+		     WHERE (length(due_at) = 10 AND due_at >= ? AND due_at < ?)
+		        OR (length(due_at) > 10 AND due_at >= ? AND due_at < ?))`,
+		boardID, boardID,
+		today, now,
+		today, weekEnd, now, weekEndAt,
+	).Scan(&stats.TotalCards, &stats.OpenCards, &stats.NoDueDate, &stats.Overdue, &stats.DueThisWeek)
+	if err != nil {
+		return fmt.Errorf("query board summary: %w", err)
+	}
+	return nil
+}
+
+func (r *StatsRepo) byColumn(ctx context.Context, stats *domain.BoardStats, boardID string) error {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		`SELECT col.id, col.title, COUNT(c.id)
+		 FROM columns col
+		 LEFT JOIN cards c ON c.column_id = col.id AND c.archived_at IS NULL
+		 WHERE col.board_id = ?
+		 GROUP BY col.id
+		 ORDER BY col.position ASC`, boardID,
+	)
+	if err != nil {
+		return fmt.Errorf("query cards per column: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cc domain.ColumnCount
+		if err := rows.Scan(&cc.ColumnID, &cc.Title, &cc.Count); err != nil {
+			return fmt.Errorf("scan column count: %w", err)
+		}
+		stats.ByColumn = append(stats.ByColumn, cc)
+	}
+	return rows.Err()
+}
+
+func (r *StatsRepo) byPriority(ctx context.Context, stats *domain.BoardStats, boardID string) error {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		`SELECT p.key, p.name, p.color, COUNT(c.id)
+		 FROM board_priorities p
+		 LEFT JOIN columns col ON col.board_id = p.board_id
+		 LEFT JOIN cards c ON c.column_id = col.id AND c.priority = p.key AND c.archived_at IS NULL
+		 WHERE p.board_id = ?
+		 GROUP BY p.key
+		 ORDER BY p.weight DESC`, boardID,
+	)
+	if err != nil {
+		return fmt.Errorf("query cards per priority: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pc domain.PriorityCount
+		if err := rows.Scan(&pc.Key, &pc.Name, &pc.Color, &pc.Count); err != nil {
+			return fmt.Errorf("scan priority count: %w", err)
+		}
+		stats.ByPriority = append(stats.ByPriority, pc)
+	}
+	return rows.Err()
+}
+
+func (r *StatsRepo) oldestOpen(ctx context.Context, stats *domain.BoardStats, boardID string) error {
+	var card domain.CardSummary
+	var createdAt string
+	err := conn(ctx, r.db).QueryRowContext(ctx, statsCTE+`
+		SELECT id, title, column_id, created_at FROM open ORDER BY created_at ASC, id ASC LIMIT 1`,
+		boardID,
+	).Scan(&card.ID, &card.Title, &card.ColumnID, &createdAt)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("query oldest open card: %w", err)
+	}
+	if card.CreatedAt, err = parseTime(createdAt); err != nil {
+		return fmt.Errorf("parse created_at: %w", err)
+	}
+	stats.OldestOpenCard = &card
+	return nil
+}
+
+// activity counts cards created, and cards entering a done column from a column
+// that is not done, in the trailing 7 and 30 days.
+func (r *StatsRepo) activity(ctx context.Context, stats *domain.BoardStats, boardID string, now time.Time) error {
+	since7, since30 := formatTime(now.AddDate(0, 0, -7).UTC()), formatTime(now.AddDate(0, 0, -30).UTC())
+	err := conn(ctx, r.db).QueryRowContext(ctx,
+		`WITH completions AS (
+		    SELECT h.card_id, h.at
+		    FROM card_history h JOIN columns col ON h.to_column_id = col.id
+		    WHERE h.board_id = ? AND h.at >= ? AND col.kind = ?
+		      AND NOT EXISTS (SELECT 1 FROM columns f
+		                      WHERE f.id = h.from_column_id AND f.board_id = h.board_id AND f.kind = ?)
+		)
+		SELECT
+		    (SELECT COUNT(*) FROM card_history WHERE board_id = ? AND kind = ? AND at >= ?),
+		    (SELECT COUNT(*) FROM card_history WHERE board_id = ? AND kind = ? AND at >= ?),
+		    (SELECT COUNT(DISTINCT card_id) FROM completions WHERE at >= ?),
+		    (SELECT COUNT(DISTINCT card_id) FROM completions)`,
+		boardID, since30, domain.ColumnKindDone, domain.ColumnKindDone,
+		boardID, domain.CardEventCreated, since7,
+		boardID, domain.CardEventCreated, since30,
+		since7,
+	).Scan(&stats.Created.Last7Days, &stats.Created.Last30Days, &stats.Completed.Last7Days, &stats.Completed.Last30Days)
+	if err != nil {
+		return fmt.Errorf("query board activity: %w", err)
+	}
+	return nil
+}
+
+func (r *StatsRepo) byAssignee(ctx context.Context, stats *domain.BoardStats, boardID string, at domain.StatsCutoffs) error {
+	today, now := at.Today.Format(time.DateOnly), formatTime(at.Now.UTC())
+	rows, err := conn(ctx, r.db).QueryContext(ctx, statsCTE+`
+		SELECT p.id, p.name, COUNT(*), COALESCE(SUM(`+overdueCond+`), 0)
+		FROM open
+		JOIN card_assignees ca ON ca.card_id = open.id
+		JOIN people p ON p.id = ca.person_id
+		GROUP BY p.id
+		ORDER BY COUNT(*) DESC, p.name COLLATE NOCASE ASC`,
+		boardID, today, now,
+	)
+	if err != nil {
+		return fmt.Errorf("query load per assignee: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var load domain.AssigneeLoad
+		if err := rows.Scan(&load.PersonID, &load.Name, &load.Open, &load.Overdue); err != nil {
+			return fmt.Errorf("scan assignee load: %w", err)
+		}
+		stats.ByAssignee = append(stats.ByAssignee, load)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	err = conn(ctx, r.db).QueryRowContext(ctx, statsCTE+`
+		SELECT COUNT(*) FROM open
+		WHERE NOT EXISTS (SELECT 1 FROM card_assignees ca WHERE ca.card_id = open.id)`,
+		boardID,
+	).Scan(&stats.UnassignedOpen)
+	if err != nil {
+		return fmt.Errorf("query unassigned open cards: %w", err)
+	}
+	return nil
+}
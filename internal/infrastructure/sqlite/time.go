@@ -9,6 +9,14 @@ func formatTime(t time.Time) string {
 	return t.Format(timeFormat)
 }
 
+// nullableTime formats an optional time for a nullable TEXT column.
+func nullableTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return formatTime(*t)
+}
+
 // parseTime parses a time string stored in SQLite, supporting RFC3339, DateTime, and date-only formats.
 func parseTime(s string) (time.Time, error) {
 	if t, err := time.Parse(timeFormat, s); err == nil {
@@ -0,0 +1,147 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// timeEntrySelect lists the columns read by scanTimeEntry; queries alias time_entries as t.
+const timeEntrySelect = `t.id, t.card_id, t.started_at, t.ended_at, t.note, t.created_at`
+
+type TimeEntryRepo struct {
+	db *sql.DB
+}
+
+func NewTimeEntryRepo(db *DB) *TimeEntryRepo {
+	return &TimeEntryRepo{db: db.DB}
+}
+
+// scanTimeEntry reads a time entry; Seconds is filled for stopped entries only.
+func scanTimeEntry(sc interface{ Scan(dest ...any) error }) (domain.TimeEntry, error) {
+	var e domain.TimeEntry
+	var startedAt, createdAt string
+	var endedAt sql.NullString
+	if err := sc.Scan(&e.ID, &e.CardID, &startedAt, &endedAt, &e.Note, &createdAt); err != nil {
+		return e, err
+	}
+	var err error
+	if e.StartedAt, err = parseTime(startedAt); err != nil {
+		return e, fmt.Errorf("parse started_at: %w", err)
+	}
+	if endedAt.Valid {
+		t, err := parseTime(endedAt.String)
+		if err != nil {
+			return e, fmt.Errorf("parse ended_at: %w", err)
+		}
+		e.EndedAt = &t
+		e.Seconds = int64(t.Sub(e.StartedAt).Seconds())
+	}
+	if e.CreatedAt, err = parseTime(createdAt); err != nil {
+		return e, fmt.Errorf("parse created_at: %w", err)
+	}
+	return e, nil
+}
+
+func (r *TimeEntryRepo) GetByID(ctx context.Context, id string) (*domain.TimeEntry, error) {
+	return r.get(ctx, id, "SELECT "+timeEntrySelect+" FROM time_entries t WHERE t.id = ?", id)
+}
+
+func (r *TimeEntryRepo) GetRunning(ctx context.Context) (*domain.TimeEntry, error) {
+	return r.get(ctx, "running", "SELECT "+timeEntrySelect+" FROM time_entries t WHERE t.ended_at IS NULL")
+}
+
+// get loads the single entry query returns; label names it in the not-found error.
+func (r *TimeEntryRepo) get(ctx context.Context, label, query string, args ...any) (*domain.TimeEntry, error) {
+	e, err := scanTimeEntry(conn(ctx, r.db).QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("time entry %s: %w", label, domain.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query time entry: %w", err)
+	}
+	return &e, nil
+}
+
+func (r *TimeEntryRepo) Create(ctx context.Context, e *domain.TimeEntry) error {
+	_, err := conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO time_entries (id, card_id, started_at, ended_at, note, created_at)
+		 VALUES (?, ?, ?, ?, ?, ?)`,
+		e.ID, e.CardID, formatTime(e.StartedAt.UTC()), nullableTime(e.EndedAt), e.Note, formatTime(e.CreatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("insert time entry: %w", err)
+	}
+	return nil
+}
+
+func (r *TimeEntryRepo) Stop(ctx context.Context, id string, at time.Time) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx,
+		"UPDATE time_entries SET ended_at = ? WHERE id = ? AND ended_at IS NULL",
+		formatTime(at.UTC()), id,
+	)
+	if err != nil {
+		return fmt.Errorf("stop time entry: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("running time entry %s: %w", id, domain.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *TimeEntryRepo) Delete(ctx context.Context, id string) error {
+	res, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM time_entries WHERE id = ?", id)
+	if err != nil {
+		return fmt.Errorf("delete time entry: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("time entry %s: %w", id, domain.ErrNotFound)
+	}
+	return nil
+}
+
+func (r *TimeEntryRepo) ListByCard(ctx context.Context, cardID string) ([]domain.TimeEntry, error) {
+	return r.list(ctx,
+		"SELECT "+timeEntrySelect+" FROM time_entries t WHERE t.card_id = ? ORDER BY t.started_at, t.id", cardID)
+}
+
+func (r *TimeEntryRepo) ListByBoard(ctx context.Context, boardID string, from, to time.Time) ([]domain.TimeEntry, error) {
+	var b strings.Builder
+	b.WriteString(`SELECT ` + timeEntrySelect + ` FROM time_entries t
+		 JOIN cards c ON c.id = t.card_id
+		 JOIN columns col ON col.id = c.column_id
+		 WHERE col.board_id = ?`)
+	args := []any{boardID}
+	if !from.IsZero() {
+		b.WriteString(" AND t.started_at >= ?")
+		args = append(args, formatTime(from.UTC()))
+	}
+	if !to.IsZero() {
+		b.WriteString(" AND t.started_at < ?")
+		args = append(args, formatTime(to.UTC()))
+	}
+	b.WriteString(" ORDER BY t.started_at, t.id")
+	return r.list(ctx, b.String(), args...)
+}
+
+func (r *TimeEntryRepo) list(ctx context.Context, query string, args ...any) ([]domain.TimeEntry, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query time entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []domain.TimeEntry
+	for rows.Next() {
+		e, err := scanTimeEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan time entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
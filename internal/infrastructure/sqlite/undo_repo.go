@@ -0,0 +1,73 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"kanban-app-playground/internal/domain"
+)
+
+type UndoRepo struct {
+	db *sql.DB
+}
+
+func NewUndoRepo(db *DB) *UndoRepo {
+	return &UndoRepo{db: db.DB}
+}
+
+// Push stores e with its card snapshots as JSON and prunes the stack to MaxUndoEntries.
+func (r *UndoRepo) Push(ctx context.Context, e *domain.UndoEntry) error {
+	cards, err := json.Marshal(e.Cards)
+	if err != nil {
+		return fmt.Errorf("encode undo cards: %w", err)
+	}
+	tx := &Transactor{db: r.db}
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		_, err := conn(ctx, r.db).ExecContext(ctx,
+			"INSERT INTO undo_entries (id, kind, cards, created_at) VALUES (?, ?, ?, ?)",
+			e.ID, e.Kind, string(cards), formatTime(e.CreatedAt),
+		)
+		if err != nil {
+			return fmt.Errorf("insert undo entry: %w", err)
+		}
+		_, err = conn(ctx, r.db).ExecContext(ctx,
+			`DELETE FROM undo_entries
+			 WHERE rowid NOT IN (SELECT rowid FROM undo_entries ORDER BY created_at DESC, rowid DESC LIMIT ?)`,
+			domain.MaxUndoEntries,
+		)
+		if err != nil {
+			return fmt.Errorf("prune undo entries: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *UndoRepo) Latest(ctx context.Context) (*domain.UndoEntry, error) {
+	var e domain.UndoEntry
+	var cards, createdAt string
+	err := conn(ctx, r.db).QueryRowContext(ctx,
+		"SELECT id, kind, cards, created_at FROM undo_entries ORDER BY created_at DESC, rowid DESC LIMIT 1",
+	).Scan(&e.ID, &e.Kind, &cards, &createdAt)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("undo entry: %w", domain.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query undo entry: %w", err)
+	}
+	if err := json.Unmarshal([]byte(cards), &e.Cards); err != nil {
+		return nil, fmt.Errorf("parse undo cards: %w", err)
+	}
+	if e.CreatedAt, err = parseTime(createdAt); err != nil {
+		return nil, fmt.Errorf("parse created_at: %w", err)
+	}
+	return &e, nil
+}
+
+func (r *UndoRepo) Delete(ctx context.Context, id string) error {
+	if _, err := conn(ctx, r.db).ExecContext(ctx, "DELETE FROM undo_entries WHERE id = ?", id); err != nil {
+		return fmt.Errorf("delete undo entry: %w", err)
+	}
+	return nil
+}
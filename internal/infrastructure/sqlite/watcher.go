@@ -0,0 +1,153 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"kanban-app-playground/internal/domain"
+)
+
+// watchInterval is how often PRAGMA data_version is polled.
+const watchInterval = time.Second
+
+// ChangeWatcher polls PRAGMA data_version to detect commits made by other processes.
+//
+// data_version only changes when a *different* connection commits, so it relies on
+// the pool being limited to a single connection (see NewDB): the app's own writes
+// never bump it, only writes from the CLI or another instance do.
+type ChangeWatcher struct {
+	db       *sql.DB
+	interval time.Duration
+}
+
+func NewChangeWatcher(db *DB) *ChangeWatcher {
+	return &ChangeWatcher{db: db.DB, interval: watchInterval}
+}
+
+// boardSignatureQuery summarises each board's rows into a string that changes
+// whenever the board, its columns or its cards are inserted, updated or deleted.
+const boardSignatureQuery = `
+SELECT b.id,
+       b.title || '|' || b.updated_at
+       || '|' || (SELECT COUNT(*) || ':' || COALESCE(SUM(position), 0) || ':' || COALESCE(group_concat(title, ','), '')
+                  FROM columns WHERE board_id = b.id)
+       || '|' || (SELECT COUNT(*) || ':' || COALESCE(SUM(c.position), 0) || ':' || COALESCE(MAX(c.updated_at), '')
+                  FROM cards c JOIN columns col ON c.column_id = col.id WHERE col.board_id = b.id)
+FROM boards b`
+
+// Watch polls until ctx is cancelled. Read errors are logged and retried on the
+// next tick, so a transient SQLITE_BUSY does not stop the watcher.
+func (w *ChangeWatcher) Watch(ctx context.Context, onChange func(domain.ExternalChange)) error {
+	// known is false until a baseline has been read; no change is reported before then.
+	var (
+		known      bool
+		version    int64
+		signatures map[string]string
+	)
+	baseline := func() {
+		v, err := w.dataVersion(ctx)
+		if err != nil {
+			log.Printf("Warning: change watcher: %v", err)
+			return
+		}
+		sigs, err := w.boardSignatures(ctx)
+		if err != nil {
+			log.Printf("Warning: change watcher: %v", err)
+			return
+		}
+		version, signatures, known = v, sigs, true
+	}
+	baseline()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		if !known {
+			baseline()
+			continue
+		}
+		v, err := w.dataVersion(ctx)
+		if err != nil {
+			log.Printf("Warning: change watcher: %v", err)
+			continue
+		}
+		if v == version {
+			continue
+		}
+		version = v
+
+		next, err := w.boardSignatures(ctx)
+		if err != nil {
+			// The signatures are now stale: let every board reload, and read a fresh
+			// baseline on the next tick instead of diffing against the old one.
+			log.Printf("Warning: change watcher: %v", err)
+			known = false
+			onChange(domain.ExternalChange{All: true})
+			continue
+		}
+		changed := diffSignatures(signatures, next)
+		signatures = next
+
+		if len(changed) == 0 {
+			// Something was written that the signatures don't cover; let every board reload.
+			onChange(domain.ExternalChange{All: true})
+			continue
+		}
+		onChange(domain.ExternalChange{BoardIDs: changed})
+	}
+}
+
+func (w *ChangeWatcher) dataVersion(ctx context.Context) (int64, error) {
+	var v int64
+	if err := w.db.QueryRowContext(ctx, "PRAGMA data_version").Scan(&v); err != nil {
+		return 0, fmt.Errorf("read data_version: %w", err)
+	}
+	return v, nil
+}
+
+func (w *ChangeWatcher) boardSignatures(ctx context.Context) (map[string]string, error) {
+	rows, err := w.db.QueryContext(ctx, boardSignatureQuery)
+	if err != nil {
+		return nil, fmt.Errorf("query board signatures: %w", err)
+	}
+	defer rows.Close()
+
+	sigs := make(map[string]string)
+	for rows.Next() {
+		var id string
+		var sig sql.NullString
+		if err := rows.Scan(&id, &sig); err != nil {
+			return nil, fmt.Errorf("scan board signature: %w", err)
+		}
+		sigs[id] = sig.String
+	}
+	return sigs, rows.Err()
+}
+
+// diffSignatures returns the sorted IDs of boards that were added, removed or modified.
+func diffSignatures(prev, next map[string]string) []string {
+	var changed []string
+	for id, sig := range next {
+		if old, ok := prev[id]; !ok || old != sig {
+			changed = append(changed, id)
+		}
+	}
+	for id := range prev {
+		if _, ok := next[id]; !ok {
+			changed = append(changed, id)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
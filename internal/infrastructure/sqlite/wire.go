@@ -22,7 +22,35 @@ var RepoSet = wire.NewSet(
 	NewBoardRepo,
 	NewColumnRepo,
 	NewCardRepo,
+	NewReminderRepo,
+	NewBoardTemplateRepo,
+	NewCardTemplateRepo,
+	NewRecurrenceRepo,
+	NewHistoryRepo,
+	NewStatsRepo,
+	NewPersonRepo,
+	NewTimeEntryRepo,
+	NewCustomFieldRepo,
+	NewWorkflowRepo,
+	NewAutomationRepo,
+	NewUndoRepo,
+	NewChangeWatcher,
+	NewTransactor,
 	wire.Bind(new(domain.BoardRepository), new(*BoardRepo)),
 	wire.Bind(new(domain.ColumnRepository), new(*ColumnRepo)),
 	wire.Bind(new(domain.CardRepository), new(*CardRepo)),
+	wire.Bind(new(domain.BoardTemplateRepository), new(*BoardTemplateRepo)),
+	wire.Bind(new(domain.CardTemplateRepository), new(*CardTemplateRepo)),
+	wire.Bind(new(domain.RecurrenceRepository), new(*RecurrenceRepo)),
+	wire.Bind(new(domain.HistoryRepository), new(*HistoryRepo)),
+	wire.Bind(new(domain.StatsRepository), new(*StatsRepo)),
+	wire.Bind(new(domain.PersonRepository), new(*PersonRepo)),
+	wire.Bind(new(domain.TimeEntryRepository), new(*TimeEntryRepo)),
+	wire.Bind(new(domain.CustomFieldRepository), new(*CustomFieldRepo)),
+	wire.Bind(new(domain.WorkflowRepository), new(*WorkflowRepo)),
+	wire.Bind(new(domain.AutomationRepository), new(*AutomationRepo)),
+	wire.Bind(new(domain.UndoRepository), new(*UndoRepo)),
+	wire.Bind(new(domain.ReminderRepository), new(*ReminderRepo)),
+	wire.Bind(new(domain.ChangeWatcher), new(*ChangeWatcher)),
+	wire.Bind(new(domain.Transactor), new(*Transactor)),
 )
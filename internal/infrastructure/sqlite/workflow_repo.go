@@ -0,0 +1,65 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"kanban-app-playground/internal/domain"
+)
+
+type WorkflowRepo struct {
+	db *sql.DB
+}
+
+func NewWorkflowRepo(db *DB) *WorkflowRepo {
+	return &WorkflowRepo{db: db.DB}
+}
+
+func (r *WorkflowRepo) GetByBoardID(ctx context.Context, boardID string) (*domain.Workflow, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx,
+		`SELECT t.from_column_id, t.to_column_id
+		 FROM column_transitions t
+		 JOIN columns f ON t.from_column_id = f.id
+		 JOIN columns col ON t.to_column_id = col.id
+		 WHERE f.board_id = ?
+		 ORDER BY f.position ASC, col.position ASC`, boardID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("query transitions: %w", err)
+	}
+	defer rows.Close()
+
+	w := &domain.Workflow{BoardID: boardID, Transitions: map[string][]string{}}
+	for rows.Next() {
+		var from, to string
+		if err := rows.Scan(&from, &to); err != nil {
+			return nil, fmt.Errorf("scan transition: %w", err)
+		}
+		w.Transitions[from] = append(w.Transitions[from], to)
+	}
+	return w, rows.Err()
+}
+
+func (r *WorkflowRepo) Replace(ctx context.Context, w *domain.Workflow) error {
+	tx := &Transactor{db: r.db}
+	return tx.WithinTx(ctx, func(ctx context.Context) error {
+		_, err := conn(ctx, r.db).ExecContext(ctx,
+			`DELETE FROM column_transitions
+			 WHERE from_column_id IN (SELECT id FROM columns WHERE board_id = ?)`, w.BoardID,
+		)
+		if err != nil {
+			return fmt.Errorf("clear transitions: %w", err)
+		}
+		for from, targets := range w.Transitions {
+			for _, to := range targets {
+				if _, err := conn(ctx, r.db).ExecContext(ctx,
+					"INSERT INTO column_transitions (from_column_id, to_column_id) VALUES (?, ?)", from, to,
+				); err != nil {
+					return fmt.Errorf("insert transition: %w", err)
+				}
+			}
+		}
+		return nil
+	})
+}
@@ -8,11 +8,13 @@ import (
 
 	"kanban-app-playground/internal/adapter"
 	"kanban-app-playground/internal/application"
+	"kanban-app-playground/internal/infrastructure/desktop"
+	"kanban-app-playground/internal/infrastructure/scripting"
 	"kanban-app-playground/internal/infrastructure/sqlite"
 )
 
 // InitializeHandler wires all dependencies and returns a ready-to-use Handler.
 func InitializeHandler() (*adapter.Handler, func(), error) {
-	wire.Build(sqlite.DBSet, sqlite.RepoSet, application.ServiceSet, adapter.HandlerSet)
+	wire.Build(sqlite.DBSet, sqlite.RepoSet, application.ServiceSet, desktop.NotifierSet, scripting.EngineSet, adapter.HandlerSet)
 	return nil, nil, nil
 }
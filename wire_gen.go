@@ -9,6 +9,8 @@ package main
 import (
 	"kanban-app-playground/internal/adapter"
 	"kanban-app-playground/internal/application"
+	"kanban-app-playground/internal/infrastructure/desktop"
+	"kanban-app-playground/internal/infrastructure/scripting"
 	"kanban-app-playground/internal/infrastructure/sqlite"
 )
 
@@ -23,10 +25,39 @@ func InitializeHandler() (*adapter.Handler, func(), error) {
 	boardRepo := sqlite.NewBoardRepo(db)
 	columnRepo := sqlite.NewColumnRepo(db)
 	cardRepo := sqlite.NewCardRepo(db)
-	boardService := application.NewBoardService(boardRepo, columnRepo, cardRepo)
-	columnService := application.NewColumnService(columnRepo, cardRepo)
-	cardService := application.NewCardService(cardRepo, columnRepo)
-	handler := adapter.NewHandler(boardService, columnService, cardService)
+	boardTemplateRepo := sqlite.NewBoardTemplateRepo(db)
+	customFieldRepo := sqlite.NewCustomFieldRepo(db)
+	workflowRepo := sqlite.NewWorkflowRepo(db)
+	activityFeed := application.NewActivityFeed()
+	transactor := sqlite.NewTransactor(db)
+	boardService := application.NewBoardService(boardRepo, columnRepo, cardRepo, boardTemplateRepo, customFieldRepo, workflowRepo, activityFeed, transactor)
+	historyRepo := sqlite.NewHistoryRepo(db)
+	columnService := application.NewColumnService(columnRepo, cardRepo, boardRepo, historyRepo, workflowRepo, activityFeed, transactor)
+	cardTemplateRepo := sqlite.NewCardTemplateRepo(db)
+	personRepo := sqlite.NewPersonRepo(db)
+	undoRepo := sqlite.NewUndoRepo(db)
+	cardService := application.NewCardService(cardRepo, columnRepo, boardRepo, cardTemplateRepo, historyRepo, personRepo, customFieldRepo, workflowRepo, undoRepo, activityFeed, transactor)
+	reminderRepo := sqlite.NewReminderRepo(db)
+	reminderService := application.NewReminderService(reminderRepo, cardRepo)
+	recurrenceRepo := sqlite.NewRecurrenceRepo(db)
+	recurrenceService := application.NewRecurrenceService(recurrenceRepo, cardRepo, columnRepo, cardTemplateRepo, cardService, activityFeed, transactor)
+	statsRepo := sqlite.NewStatsRepo(db)
+	analyticsService := application.NewAnalyticsService(boardRepo, columnRepo, cardRepo, historyRepo, statsRepo, customFieldRepo)
+	personService := application.NewPersonService(personRepo)
+	timeEntryRepo := sqlite.NewTimeEntryRepo(db)
+	timeService := application.NewTimeService(timeEntryRepo, cardRepo, transactor)
+	customFieldService := application.NewCustomFieldService(customFieldRepo, boardRepo, columnRepo, cardRepo, transactor)
+	automationRepo := sqlite.NewAutomationRepo(db)
+	automationService := application.NewAutomationService(automationRepo, cardRepo, columnRepo, boardRepo, customFieldRepo, reminderRepo, cardService, customFieldService, activityFeed)
+	engine, err := scripting.NewEngine()
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	scriptService := application.NewScriptService(engine, cardRepo, boardService, cardService, customFieldService, activityFeed)
+	changeWatcher := sqlite.NewChangeWatcher(db)
+	notifier := desktop.NewNotifier()
+	handler := adapter.NewHandler(boardService, columnService, cardService, reminderService, recurrenceService, analyticsService, personService, timeService, customFieldService, automationService, scriptService, changeWatcher, notifier)
 	return handler, func() {
 		cleanup()
 	}, nil